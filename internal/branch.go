@@ -0,0 +1,317 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	easyjson "github.com/mailru/easyjson"
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+// maxBranchesPerQuery is the number of branches compared against the default branch in one query
+const maxBranchesPerQuery = 25
+
+type branchRef struct {
+	Name   string `json:"name"`
+	Target struct {
+		Oid           string    `json:"oid"`
+		CommittedDate time.Time `json:"committedDate"`
+	} `json:"target"`
+}
+
+type branchComparison struct {
+	AheadBy  int64  `json:"aheadBy"`
+	BehindBy int64  `json:"behindBy"`
+	Status   string `json:"status"`
+	Commits  struct {
+		Nodes []struct {
+			Oid           string    `json:"oid"`
+			CommittedDate time.Time `json:"committedDate"`
+		} `json:"nodes"`
+	} `json:"commits"`
+}
+
+type branchNamesResult struct {
+	RateLimit  rateLimit `json:"rateLimit"`
+	Repository struct {
+		Refs struct {
+			PageInfo pageInfo `json:"pageInfo"`
+			Nodes    []struct {
+				Name string `json:"name"`
+			} `json:"nodes"`
+		} `json:"refs"`
+	} `json:"repository"`
+}
+
+// branchState is the first commit sha for each branch in a repo which we need to build the branch id once the
+// branch has been merged or deleted
+type branchState map[string]string
+
+func (g *GithubIntegration) getBranchesKey(repoName string) string {
+	return fmt.Sprintf("branches_%s", repoName)
+}
+
+func (g *GithubIntegration) getBranchState(state sdk.State, repoName string) (branchState, error) {
+	branches := make(branchState)
+	if _, err := state.Get(g.getBranchesKey(repoName), &branches); err != nil {
+		return nil, fmt.Errorf("error fetching branch state for %s: %w", repoName, err)
+	}
+	return branches, nil
+}
+
+func newBranchModel(customerID string, integrationInstanceID string, repoName string, repoID string, repoURL string, name string, firstCommitSha string) *sdk.SourceCodeBranch {
+	branch := &sdk.SourceCodeBranch{}
+	branch.CustomerID = customerID
+	branch.RefType = refType
+	branch.RefID = repoName + ":" + name
+	branch.RepoID = repoID
+	branch.Name = name
+	branch.URL = repoURL + "/tree/" + name
+	branch.IntegrationInstanceID = sdk.StringPointer(integrationInstanceID)
+	branch.Active = true
+	if firstCommitSha != "" {
+		branch.FirstCommitSha = firstCommitSha
+		branch.FirstCommitID = sdk.NewSourceCodeCommitID(customerID, firstCommitSha, refType, repoID)
+	}
+	// the first commit isn't known until the branch has commits of its own so only use the name for the id
+	branch.ID = sdk.NewSourceCodeBranchID(refType, repoID, customerID, name, "")
+	branch.UpdatedAt = sdk.EpochNow()
+	return branch
+}
+
+// isCommitOnBranch returns true if the commit is reachable from the branch
+func (g *GithubIntegration) isCommitOnBranch(httpclient sdk.HTTPClient, repoName string, branch string, sha string) (bool, error) {
+	var result struct {
+		Status string `json:"status"`
+	}
+	params := url.Values{}
+	params.Set("per_page", "1")
+	if _, err := httpclient.Get(&result, sdk.WithEndpoint("/repos/"+repoName+"/compare/"+sha+"..."+branch), sdk.WithGetQueryParameters(params)); err != nil {
+		if isQueryErrorType(err, queryErrorNotFound) {
+			// the commit was force pushed away
+			return false, nil
+		}
+		return false, fmt.Errorf("error comparing %s with %s for %s: %w", sha, branch, repoName, err)
+	}
+	return result.Status == "ahead" || result.Status == "identical", nil
+}
+
+// branchesQuery builds the query to fetch the head of each branch and compare it against the default branch
+func branchesQuery(count int) string {
+	var args, refs, compares strings.Builder
+	for i := 0; i < count; i++ {
+		args.WriteString(fmt.Sprintf(", $b%d: String!", i))
+		refs.WriteString(fmt.Sprintf(`
+		r%d: ref(qualifiedName: $b%d) {
+			name
+			target {
+				...on Commit {
+					oid
+					committedDate
+				}
+			}
+		}`, i, i))
+		compares.WriteString(fmt.Sprintf(`
+			c%d: compare(headRef: $b%d) {
+				aheadBy
+				behindBy
+				status
+				commits(first: 1) {
+					nodes {
+						oid
+						committedDate
+					}
+				}
+			}`, i, i))
+	}
+	return fmt.Sprintf(`query GetBranches($name: String!, $owner: String!%s) {
+	repository(name: $name, owner: $owner) {
+		defaultBranchRef {
+			name%s
+		}%s
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}`, args.String(), compares.String(), refs.String())
+}
+
+// writeBranches will fetch the branches by name, compare each one against the default branch and write them to the pipe.
+// a branch which no longer exists is written as inactive
+func (g *GithubIntegration) writeBranches(logger sdk.Logger, exec *queryExecutor, httpclient sdk.HTTPClient, pipe sdk.Pipe, branches branchState, customerID string, integrationInstanceID string, repoName string, repoID string, repoURL string, names []string) error {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	for offset := 0; offset < len(names); offset += maxBranchesPerQuery {
+		end := offset + maxBranchesPerQuery
+		if end > len(names) {
+			end = len(names)
+		}
+		batch := names[offset:end]
+		variables := map[string]interface{}{
+			"owner": repoOwner,
+			"name":  repoLogin,
+		}
+		for i, name := range batch {
+			variables[fmt.Sprintf("b%d", i)] = "refs/heads/" + name
+		}
+		var result struct {
+			RateLimit  rateLimit                  `json:"rateLimit"`
+			Repository map[string]json.RawMessage `json:"repository"`
+		}
+		if err := exec.Query(branchesQuery(len(batch)), variables, &result); err != nil {
+			return fmt.Errorf("error fetching branches for %s: %w", repoName, err)
+		}
+		var defaultBranch map[string]json.RawMessage
+		if buf := result.Repository["defaultBranchRef"]; buf != nil {
+			if err := json.Unmarshal(buf, &defaultBranch); err != nil {
+				return err
+			}
+		}
+		var defaultName string
+		if buf := defaultBranch["name"]; buf != nil {
+			if err := json.Unmarshal(buf, &defaultName); err != nil {
+				return err
+			}
+		}
+		for i, name := range batch {
+			var ref *branchRef
+			if buf := result.Repository[fmt.Sprintf("r%d", i)]; buf != nil && string(buf) != "null" {
+				ref = &branchRef{}
+				if err := easyjson.Unmarshal(buf, ref); err != nil {
+					return err
+				}
+			}
+			if ref == nil {
+				// the branch has been deleted
+				if _, ok := branches[name]; ok {
+					branch := newBranchModel(customerID, integrationInstanceID, repoName, repoID, repoURL, name, branches[name])
+					branch.Active = false
+					if err := pipe.Write(branch); err != nil {
+						return err
+					}
+					delete(branches, name)
+				}
+				continue
+			}
+			var comparison branchComparison
+			if buf := defaultBranch[fmt.Sprintf("c%d", i)]; buf != nil && string(buf) != "null" {
+				if err := easyjson.Unmarshal(buf, &comparison); err != nil {
+					return err
+				}
+			}
+			// remember the first commit on the branch since it's no longer returned once the branch is merged
+			firstCommitSha := branches[name]
+			var firstCommitDate time.Time
+			if len(comparison.Commits.Nodes) > 0 {
+				firstCommitSha = comparison.Commits.Nodes[0].Oid
+				firstCommitDate = comparison.Commits.Nodes[0].CommittedDate
+			}
+			branch := newBranchModel(customerID, integrationInstanceID, repoName, repoID, repoURL, name, firstCommitSha)
+			branch.Default = name == defaultName
+			branch.LatestCommitSha = ref.Target.Oid
+			branch.LatestCommitID = sdk.NewSourceCodeCommitID(customerID, ref.Target.Oid, refType, repoID)
+			branch.AheadDefaultCount = comparison.AheadBy
+			branch.BehindDefaultCount = comparison.BehindBy
+			if !branch.Default && comparison.AheadBy == 0 && firstCommitSha != "" {
+				// the branch could have been reset rather than merged so check its own commits made it to the default branch
+				merged, err := g.isCommitOnBranch(httpclient, repoName, defaultName, firstCommitSha)
+				if err != nil {
+					return err
+				}
+				branch.Merged = merged
+			}
+			if !firstCommitDate.IsZero() {
+				sdk.ConvertTimeToDateModel(firstCommitDate, &branch.FirstCommitDate)
+			}
+			if err := pipe.Write(branch); err != nil {
+				return err
+			}
+			branches[name] = firstCommitSha
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportBranches will export all the branches for the repo, deactivating any which were deleted since the previous export
+func (g *GithubIntegration) exportBranches(logger sdk.Logger, client sdk.GraphQLClient, httpclient sdk.HTTPClient, export sdk.Export, repoName string, repoID string, repoURL string) error {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	state := export.State()
+	exec := g.newQueryExecutor(logger, client, export)
+	branches, err := g.getBranchState(state, repoName)
+	if err != nil {
+		return err
+	}
+	variables := map[string]interface{}{
+		"first": defaultPageSize,
+		"owner": repoOwner,
+		"name":  repoLogin,
+	}
+	found := make(map[string]bool)
+	names := make([]string, 0)
+	for {
+		sdk.LogDebug(logger, "running fetch branch names", "name", repoName, "after", variables["after"])
+		var result branchNamesResult
+		if err := exec.Query(branchNamesQuery, variables, &result); err != nil {
+			return fmt.Errorf("error fetching branch names for %s: %w", repoName, err)
+		}
+		for _, node := range result.Repository.Refs.Nodes {
+			found[node.Name] = true
+			names = append(names, node.Name)
+		}
+		if !result.Repository.Refs.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return err
+		}
+		variables["after"] = result.Repository.Refs.PageInfo.EndCursor
+	}
+	// include the branches from the previous export which are gone so they are deactivated
+	for name := range branches {
+		if !found[name] {
+			names = append(names, name)
+		}
+	}
+	if err := g.writeBranches(logger, exec, httpclient, export.Pipe(), branches, export.CustomerID(), export.IntegrationInstanceID(), repoName, repoID, repoURL, names); err != nil {
+		return err
+	}
+	sdk.LogDebug(logger, "fetched branches", "name", repoName, "count", len(found))
+	return state.Set(g.getBranchesKey(repoName), branches)
+}
+
+// updateBranch will fetch the branch after it was created, pushed to or deleted and write it to the pipe
+func (g *GithubIntegration) updateBranch(logger sdk.Logger, client sdk.GraphQLClient, httpclient sdk.HTTPClient, control sdk.Control, state sdk.State, pipe sdk.Pipe, customerID string, integrationInstanceID string, repo *github.Repository, name string) error {
+	repoName := repo.GetFullName()
+	branches, err := g.getBranchState(state, repoName)
+	if err != nil {
+		return err
+	}
+	repoID := sdk.NewSourceCodeRepoID(customerID, repo.GetNodeID(), refType)
+	exec := g.newQueryExecutor(logger, client, control)
+	if err := g.writeBranches(logger, exec, httpclient, pipe, branches, customerID, integrationInstanceID, repoName, repoID, repo.GetHTMLURL(), []string{name}); err != nil {
+		return err
+	}
+	return state.Set(g.getBranchesKey(repoName), branches)
+}
+
+// fromPushBranchEvent will update the branch which was pushed to
+func (g *GithubIntegration) fromPushBranchEvent(logger sdk.Logger, client sdk.GraphQLClient, httpclient sdk.HTTPClient, control sdk.Control, state sdk.State, pipe sdk.Pipe, customerID string, integrationInstanceID string, push *github.PushEvent) error {
+	if !strings.HasPrefix(push.GetRef(), "refs/heads/") {
+		return nil
+	}
+	repo := &github.Repository{
+		NodeID:   push.GetRepo().NodeID,
+		FullName: push.GetRepo().FullName,
+		HTMLURL:  push.GetRepo().HTMLURL,
+	}
+	return g.updateBranch(logger, client, httpclient, control, state, pipe, customerID, integrationInstanceID, repo, strings.TrimPrefix(push.GetRef(), "refs/heads/"))
+}
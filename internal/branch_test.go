@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBranchesQuery(t *testing.T) {
+	assert := assert.New(t)
+	q := branchesQuery(2)
+	assert.Equal("GetBranches", queryKey(q))
+	assert.Contains(q, "$b0: String!, $b1: String!")
+	assert.Contains(q, "c1: compare(headRef: $b1)")
+	assert.Contains(q, "r1: ref(qualifiedName: $b1)")
+	assert.NotContains(q, "$b2")
+}
+
+func TestIsCommitOnBranch(t *testing.T) {
+	assert := assert.New(t)
+	var endpoint string
+	status := "ahead"
+	client := &MockHTTPClient{
+		Callback: func(method string, data io.Reader, out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+			endpoint = getEndpoint(options)
+			if status == "" {
+				return &sdk.HTTPResponse{StatusCode: http.StatusNotFound}, errors.New("Not Found")
+			}
+			return &sdk.HTTPResponse{StatusCode: http.StatusOK}, json.Unmarshal([]byte(`{"status":"`+status+`"}`), out)
+		},
+	}
+	g := &GithubIntegration{}
+	merged, err := g.isCommitOnBranch(client, "pinpt/agent", "main", "abc")
+	assert.NoError(err)
+	assert.True(merged)
+	assert.Equal("/repos/pinpt/agent/compare/abc...main", endpoint)
+	status = "diverged"
+	merged, err = g.isCommitOnBranch(client, "pinpt/agent", "main", "abc")
+	assert.NoError(err)
+	assert.False(merged)
+	status = ""
+	merged, err = g.isCommitOnBranch(client, "pinpt/agent", "main", "abc")
+	assert.NoError(err)
+	assert.False(merged)
+}
+
+func TestNewBranchModelID(t *testing.T) {
+	assert := assert.New(t)
+	before := newBranchModel("1234", "5678", "pinpt/agent", "R_1", "https://github.com/pinpt/agent", "feature", "")
+	after := newBranchModel("1234", "5678", "pinpt/agent", "R_1", "https://github.com/pinpt/agent", "feature", "abc")
+	assert.Equal(before.ID, after.ID)
+	assert.Equal("abc", after.FirstCommitSha)
+}
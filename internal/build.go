@@ -0,0 +1,295 @@
+package internal
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+const (
+	// buildHistory is how far back a historical export fetches builds
+	buildHistory = time.Hour * 24 * 90
+	// actionsAppSlug is the app for check suites which are github actions workflow runs
+	actionsAppSlug = "github-actions"
+)
+
+// workflowRun adds the name of the workflow which go-github doesn't have
+// easyjson:skip
+type workflowRun struct {
+	github.WorkflowRun
+	Name string `json:"name"`
+}
+
+// easyjson:skip
+type workflowRuns struct {
+	TotalCount   int            `json:"total_count"`
+	WorkflowRuns []*workflowRun `json:"workflow_runs"`
+}
+
+// workflowRunEvent is the workflow_run webhook which isn't supported by go-github yet
+// easyjson:skip
+type workflowRunEvent struct {
+	Action      *string            `json:"action,omitempty"`
+	WorkflowRun *workflowRun       `json:"workflow_run,omitempty"`
+	Repo        *github.Repository `json:"repository,omitempty"`
+}
+
+// workflowJobEvent is the workflow_job webhook which isn't supported by go-github yet
+// easyjson:skip
+type workflowJobEvent struct {
+	Action      *string             `json:"action,omitempty"`
+	WorkflowJob *github.WorkflowJob `json:"workflow_job,omitempty"`
+	Repo        *github.Repository  `json:"repository,omitempty"`
+}
+
+// GetAction returns the action of the event
+func (e *workflowRunEvent) GetAction() string {
+	if e.Action == nil {
+		return ""
+	}
+	return *e.Action
+}
+
+// GetAction returns the action of the event
+func (e *workflowJobEvent) GetAction() string {
+	if e.Action == nil {
+		return ""
+	}
+	return *e.Action
+}
+
+func buildStatus(conclusion string) sdk.CICDBuildStatus {
+	switch conclusion {
+	case "success":
+		return sdk.CICDBuildStatusPass
+	case "cancelled", "skipped", "neutral", "stale":
+		return sdk.CICDBuildStatusCancel
+	}
+	return sdk.CICDBuildStatusFail
+}
+
+func newBuildModel(customerID string, integrationInstanceID string, repoName string, repoID string, refID string, sha string) *sdk.CICDBuild {
+	build := &sdk.CICDBuild{}
+	build.CustomerID = customerID
+	build.RefType = refType
+	build.RefID = refID
+	build.ID = sdk.NewCICDBuildID(customerID, refID, refType)
+	build.IntegrationInstanceID = sdk.StringPointer(integrationInstanceID)
+	build.RepoID = repoID
+	build.RepoName = repoName
+	build.CommitSha = sha
+	build.CommitID = sdk.NewSourceCodeCommitID(customerID, sha, refType, repoID)
+	build.Automated = true
+	build.Jobs = make([]sdk.CICDBuildJobs, 0)
+	return build
+}
+
+func newBuildJob(name string, url string, conclusion string, started time.Time, completed time.Time) sdk.CICDBuildJobs {
+	job := sdk.CICDBuildJobs{
+		Name:   name,
+		URL:    url,
+		Status: buildStatus(conclusion),
+	}
+	if !started.IsZero() {
+		sdk.ConvertTimeToDateModel(started, &job.StartDate)
+	}
+	if !completed.IsZero() {
+		sdk.ConvertTimeToDateModel(completed, &job.EndDate)
+		if !started.IsZero() {
+			job.Duration = completed.Sub(started).Milliseconds()
+		}
+	}
+	return job
+}
+
+func setBuildDates(build *sdk.CICDBuild, created time.Time, started time.Time, completed time.Time) {
+	sdk.ConvertTimeToDateModel(created, &build.CreatedDate)
+	if !started.IsZero() {
+		sdk.ConvertTimeToDateModel(started, &build.StartDate)
+	}
+	if !completed.IsZero() {
+		sdk.ConvertTimeToDateModel(completed, &build.EndDate)
+		if !started.IsZero() {
+			build.Duration = completed.Sub(started).Milliseconds()
+		}
+	}
+}
+
+func workflowRunToModel(customerID string, integrationInstanceID string, repoName string, repoID string, run *workflowRun, jobs []*github.WorkflowJob) *sdk.CICDBuild {
+	build := newBuildModel(customerID, integrationInstanceID, repoName, repoID, run.GetNodeID(), run.GetHeadSHA())
+	build.Name = fmt.Sprintf("%s #%d", run.Name, run.GetRunNumber())
+	build.Branch = run.GetHeadBranch()
+	build.URL = run.GetHTMLURL()
+	build.Event = run.GetEvent()
+	build.Status = buildStatus(run.GetConclusion())
+	setBuildDates(build, run.GetCreatedAt().Time, run.GetCreatedAt().Time, run.GetUpdatedAt().Time)
+	for _, job := range jobs {
+		build.Jobs = append(build.Jobs, newBuildJob(job.GetName(), job.GetHTMLURL(), job.GetConclusion(), job.GetStartedAt().Time, job.GetCompletedAt().Time))
+	}
+	return build
+}
+
+func checkSuiteToModel(customerID string, integrationInstanceID string, repoName string, repoID string, suite *github.CheckSuite, runs []*github.CheckRun) *sdk.CICDBuild {
+	build := newBuildModel(customerID, integrationInstanceID, repoName, repoID, suite.GetNodeID(), suite.GetHeadSHA())
+	build.Name = suite.GetApp().GetName()
+	build.Branch = suite.GetHeadBranch()
+	build.Event = "check_suite"
+	build.Status = buildStatus(suite.GetConclusion())
+	// the check suite doesn't have any dates so use the dates of the check runs
+	var started, completed time.Time
+	for _, run := range runs {
+		if build.URL == "" {
+			build.URL = run.GetDetailsURL()
+		}
+		runStarted := run.GetStartedAt().Time
+		runCompleted := run.GetCompletedAt().Time
+		if started.IsZero() || (!runStarted.IsZero() && runStarted.Before(started)) {
+			started = runStarted
+		}
+		if runCompleted.After(completed) {
+			completed = runCompleted
+		}
+		build.Jobs = append(build.Jobs, newBuildJob(run.GetName(), run.GetHTMLURL(), run.GetConclusion(), runStarted, runCompleted))
+	}
+	setBuildDates(build, started, started, completed)
+	return build
+}
+
+// isBuildsNotAvailable returns true if the error is because the app or token can't read the actions or checks for the repo
+func isBuildsNotAvailable(err error) bool {
+	return isQueryErrorType(err, queryErrorForbidden) || isQueryErrorType(err, queryErrorNotFound)
+}
+
+func (g *GithubIntegration) getBuildsKey(repoName string) string {
+	return fmt.Sprintf("builds_%s", repoName)
+}
+
+func (g *GithubIntegration) fetchWorkflowJobs(httpclient sdk.HTTPClient, repoName string, runID int64) ([]*github.WorkflowJob, error) {
+	jobs := make([]*github.WorkflowJob, 0)
+	for page := 1; ; page++ {
+		var result github.Jobs
+		params := url.Values{}
+		params.Set("per_page", "100")
+		params.Set("page", strconv.Itoa(page))
+		if _, err := httpclient.Get(&result, sdk.WithEndpoint(fmt.Sprintf("/repos/%s/actions/runs/%d/jobs", repoName, runID)), sdk.WithGetQueryParameters(params)); err != nil {
+			return nil, fmt.Errorf("error fetching jobs for workflow run %d in %s: %w", runID, repoName, err)
+		}
+		jobs = append(jobs, result.Jobs...)
+		if len(result.Jobs) < 100 || len(jobs) >= result.GetTotalCount() {
+			break
+		}
+	}
+	return jobs, nil
+}
+
+// exportBuilds will export the completed github actions workflow runs for the repo. a historical export only goes
+// back buildHistory and an incremental fetches the runs created since the previous export
+func (g *GithubIntegration) exportBuilds(logger sdk.Logger, httpclient sdk.HTTPClient, errs *exportErrors, export sdk.Export, repoName string, repoID string, historical bool) error {
+	state := export.State()
+	pipe := export.Pipe()
+	started := time.Now()
+	since := started.Add(-buildHistory)
+	if !historical {
+		var previous time.Time
+		if found, _ := state.Get(g.getBuildsKey(repoName), &previous); found {
+			since = previous
+		}
+	}
+	var count int
+	for page := 1; ; page++ {
+		var result workflowRuns
+		params := url.Values{}
+		params.Set("per_page", "100")
+		params.Set("page", strconv.Itoa(page))
+		params.Set("status", "completed")
+		params.Set("created", ">="+since.UTC().Format(time.RFC3339))
+		sdk.LogDebug(logger, "running fetch workflow runs", "name", repoName, "page", page)
+		if _, err := httpclient.Get(&result, sdk.WithEndpoint("/repos/"+repoName+"/actions/runs"), sdk.WithGetQueryParameters(params)); err != nil {
+			if isBuildsNotAvailable(err) {
+				// actions aren't enabled for this repo or we don't have actions:read
+				sdk.LogInfo(logger, "workflow runs not available, skipping builds", "name", repoName, "err", err)
+				return nil
+			}
+			return fmt.Errorf("error fetching workflow runs for %s: %w", repoName, err)
+		}
+		for _, run := range result.WorkflowRuns {
+			jobs, err := g.fetchWorkflowJobs(httpclient, repoName, run.GetID())
+			if err != nil {
+				if isFatalExportError(err) {
+					return err
+				}
+				errs.Add(repoName, "build", run.GetNodeID(), err)
+				continue
+			}
+			if err := pipe.Write(workflowRunToModel(export.CustomerID(), export.IntegrationInstanceID(), repoName, repoID, run, jobs)); err != nil {
+				return err
+			}
+			count++
+		}
+		if len(result.WorkflowRuns) < 100 {
+			break
+		}
+	}
+	sdk.LogDebug(logger, "fetched workflow runs", "name", repoName, "count", count)
+	return state.Set(g.getBuildsKey(repoName), started)
+}
+
+// fetchWorkflowRunBuild returns the build for the workflow run once it has completed
+func (g *GithubIntegration) fetchWorkflowRunBuild(httpclient sdk.HTTPClient, customerID string, integrationInstanceID string, repo *github.Repository, runID int64) (*sdk.CICDBuild, error) {
+	var run workflowRun
+	if _, err := httpclient.Get(&run, sdk.WithEndpoint(fmt.Sprintf("/repos/%s/actions/runs/%d", repo.GetFullName(), runID))); err != nil {
+		if isBuildsNotAvailable(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching workflow run %d for %s: %w", runID, repo.GetFullName(), err)
+	}
+	if run.GetStatus() != "completed" {
+		return nil, nil
+	}
+	jobs, err := g.fetchWorkflowJobs(httpclient, repo.GetFullName(), runID)
+	if err != nil {
+		if isBuildsNotAvailable(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	repoID := sdk.NewSourceCodeRepoID(customerID, repo.GetNodeID(), refType)
+	return workflowRunToModel(customerID, integrationInstanceID, repo.GetFullName(), repoID, &run, jobs), nil
+}
+
+// fetchCheckSuiteBuild returns the build for a check suite from a ci system other than github actions once it has completed
+func (g *GithubIntegration) fetchCheckSuiteBuild(httpclient sdk.HTTPClient, customerID string, integrationInstanceID string, repo *github.Repository, suiteID int64) (*sdk.CICDBuild, error) {
+	var suite github.CheckSuite
+	if _, err := httpclient.Get(&suite, sdk.WithEndpoint(fmt.Sprintf("/repos/%s/check-suites/%d", repo.GetFullName(), suiteID))); err != nil {
+		if isBuildsNotAvailable(err) {
+			// we don't have checks:read for the repo
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error fetching check suite %d for %s: %w", suiteID, repo.GetFullName(), err)
+	}
+	if suite.GetStatus() != "completed" || suite.GetApp().GetSlug() == actionsAppSlug {
+		// github actions are exported from the workflow run
+		return nil, nil
+	}
+	checkRuns := make([]*github.CheckRun, 0)
+	params := url.Values{}
+	params.Set("per_page", "100")
+	for params != nil {
+		var runs github.ListCheckRunsResults
+		resp, err := httpclient.Get(&runs, sdk.WithEndpoint(fmt.Sprintf("/repos/%s/check-suites/%d/check-runs", repo.GetFullName(), suiteID)), sdk.WithGetQueryParameters(params))
+		if err != nil {
+			if isBuildsNotAvailable(err) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("error fetching check runs for check suite %d for %s: %w", suiteID, repo.GetFullName(), err)
+		}
+		checkRuns = append(checkRuns, runs.CheckRuns...)
+		params = pageLinkParams(resp, "next")
+	}
+	repoID := sdk.NewSourceCodeRepoID(customerID, repo.GetNodeID(), refType)
+	return checkSuiteToModel(customerID, integrationInstanceID, repo.GetFullName(), repoID, &suite, checkRuns), nil
+}
@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWorkflowRunWebHook(t *testing.T) {
+	assert := assert.New(t)
+	obj, err := parseWebHook("workflow_run", []byte(`{"action":"completed","workflow_run":{"id":1,"node_id":"abc","name":"CI","run_number":12,"head_sha":"123","conclusion":"failure","created_at":"2020-10-01T10:00:00Z","updated_at":"2020-10-01T10:05:00Z"},"repository":{"full_name":"pinpt/agent","node_id":"repo"}}`))
+	assert.NoError(err)
+	event, ok := obj.(*workflowRunEvent)
+	assert.True(ok)
+	assert.Equal("completed", event.GetAction())
+	build := workflowRunToModel("1234", "5678", "pinpt/agent", "repo", event.WorkflowRun, nil)
+	assert.Equal("CI #12", build.Name)
+	assert.Equal("abc", build.RefID)
+	assert.Equal("123", build.CommitSha)
+	assert.Equal(sdk.CICDBuildStatusFail, build.Status)
+	assert.EqualValues(5*60*1000, build.Duration)
+}
+
+func TestFetchCheckSuiteBuild(t *testing.T) {
+	assert := assert.New(t)
+	var pages int
+	client := &MockHTTPClient{
+		Callback: func(method string, data io.Reader, out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+			resp := &sdk.HTTPResponse{StatusCode: http.StatusOK, Headers: http.Header{}}
+			if !strings.HasSuffix(getEndpoint(options), "/check-runs") {
+				return resp, json.Unmarshal([]byte(`{"id":1,"node_id":"CS_1","head_sha":"123","status":"completed","conclusion":"success","app":{"slug":"circleci","name":"CircleCI"}}`), out)
+			}
+			pages++
+			if pages == 1 {
+				resp.Headers.Set("Link", `<https://api.github.com/repos/pinpt/agent/check-suites/1/check-runs?per_page=100&page=2>; rel="next"`)
+				return resp, json.Unmarshal([]byte(`{"total_count":2,"check_runs":[{"name":"build"}]}`), out)
+			}
+			return resp, json.Unmarshal([]byte(`{"total_count":2,"check_runs":[{"name":"test"}]}`), out)
+		},
+	}
+	g := &GithubIntegration{}
+	repo := &github.Repository{FullName: github.String("pinpt/agent"), NodeID: github.String("repo")}
+	build, err := g.fetchCheckSuiteBuild(client, "1234", "5678", repo, 1)
+	assert.NoError(err)
+	assert.Equal(2, pages)
+	assert.Equal("CircleCI", build.Name)
+	assert.Len(build.Jobs, 2)
+}
+
+func TestExportBuildsNotAvailable(t *testing.T) {
+	assert := assert.New(t)
+	client := &MockHTTPClient{
+		Callback: func(method string, data io.Reader, out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+			return &sdk.HTTPResponse{StatusCode: http.StatusForbidden}, errors.New("Resource not accessible by integration")
+		},
+	}
+	g := &GithubIntegration{}
+	state := &mockState{}
+	export := &mockExport{state: state, pipe: &mockPipe{}}
+	var errs exportErrors
+	assert.NoError(g.exportBuilds(sdk.NewNoOpTestLogger(), client, &errs, export, "pinpt/agent", "R_1", false))
+	assert.Equal(0, errs.Len())
+	found, _ := state.Get(g.getBuildsKey("pinpt/agent"), nil)
+	assert.False(found)
+	build, err := g.fetchCheckSuiteBuild(client, "1234", "5678", &github.Repository{FullName: github.String("pinpt/agent")}, 1)
+	assert.NoError(err)
+	assert.Nil(build)
+}
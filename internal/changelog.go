@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+type issueTimelineItem struct {
+	Type                      string    `json:"type"`
+	ID                        string    `json:"id"`
+	CreatedAt                 time.Time `json:"createdAt"`
+	Actor                     *author   `json:"actor"`
+	Label                     *nameProp `json:"label"`
+	Assignee                  *author   `json:"assignee"`
+	MilestoneTitle            string    `json:"milestoneTitle"`
+	PreviousTitle             string    `json:"previousTitle"`
+	CurrentTitle              string    `json:"currentTitle"`
+	PreviousProjectColumnName string    `json:"previousProjectColumnName"`
+	ProjectColumnName         string    `json:"projectColumnName"`
+}
+
+type issueTimelineItems struct {
+	PageInfo pageInfo            `json:"pageInfo"`
+	Nodes    []issueTimelineItem `json:"nodes"`
+}
+
+// ToModel returns the change for the timeline item or nil if it isn't a change we track
+func (t issueTimelineItem) ToModel(logger sdk.Logger, userManager *UserManager, customerID string) (*sdk.WorkIssueChangeLog, error) {
+	change := &sdk.WorkIssueChangeLog{}
+	change.RefID = t.ID
+	sdk.ConvertTimeToDateModel(t.CreatedAt, &change.CreatedDate)
+	switch t.Type {
+	case "ClosedEvent", "ReopenedEvent":
+		from, to := "Open", "Closed"
+		if t.Type == "ReopenedEvent" {
+			from, to = to, from
+		}
+		change.Field = sdk.WorkIssueChangeLogFieldStatus
+		change.From = sdk.NewWorkIssueStatusID(customerID, refType, from)
+		change.FromString = from
+		change.To = sdk.NewWorkIssueStatusID(customerID, refType, to)
+		change.ToString = to
+	case "LabeledEvent", "UnlabeledEvent":
+		if t.Label == nil {
+			return nil, nil
+		}
+		change.Field = sdk.WorkIssueChangeLogFieldTags
+		if t.Type == "LabeledEvent" {
+			change.To = t.Label.Name
+			change.ToString = t.Label.Name
+		} else {
+			change.From = t.Label.Name
+			change.FromString = t.Label.Name
+		}
+	case "AssignedEvent", "UnassignedEvent":
+		if t.Assignee == nil {
+			return nil, nil
+		}
+		change.Field = sdk.WorkIssueChangeLogFieldAssigneeRefID
+		if t.Type == "AssignedEvent" {
+			change.To = t.Assignee.RefID(customerID)
+			change.ToString = t.Assignee.Login
+		} else {
+			change.From = t.Assignee.RefID(customerID)
+			change.FromString = t.Assignee.Login
+		}
+		if err := userManager.emitAuthor(logger, *t.Assignee); err != nil {
+			return nil, err
+		}
+	case "MilestonedEvent", "DemilestonedEvent":
+		// milestones are the parent of the issue but the event only has the title of the milestone
+		change.Field = sdk.WorkIssueChangeLogFieldParentID
+		if t.Type == "MilestonedEvent" {
+			change.ToString = t.MilestoneTitle
+		} else {
+			change.FromString = t.MilestoneTitle
+		}
+	case "RenamedTitleEvent":
+		change.Field = sdk.WorkIssueChangeLogFieldTitle
+		change.From = t.PreviousTitle
+		change.FromString = t.PreviousTitle
+		change.To = t.CurrentTitle
+		change.ToString = t.CurrentTitle
+	case "MovedColumnsInProjectEvent":
+		change.Field = sdk.WorkIssueChangeLogFieldProjectColumn
+		change.From = t.PreviousProjectColumnName
+		change.FromString = t.PreviousProjectColumnName
+		change.To = t.ProjectColumnName
+		change.ToString = t.ProjectColumnName
+	default:
+		return nil, nil
+	}
+	if t.Actor != nil {
+		change.UserID = t.Actor.RefID(customerID)
+		if err := userManager.emitAuthor(logger, *t.Actor); err != nil {
+			return nil, err
+		}
+	}
+	return change, nil
+}
+
+// fetchIssueTimeline returns the timeline of the issue after the cursor
+func (g *GithubIntegration) fetchIssueTimeline(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, issueID string, cursor string) ([]issueTimelineItem, error) {
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"first": 100,
+	}
+	if cursor != "" {
+		variables["after"] = cursor
+	}
+	exec := g.newQueryExecutor(logger, client, control)
+	items := make([]issueTimelineItem, 0)
+	for {
+		var result struct {
+			RateLimit rateLimit `json:"rateLimit"`
+			Node      struct {
+				TimelineItems issueTimelineItems `json:"timelineItems"`
+			} `json:"node"`
+		}
+		if err := exec.Query(issueTimelinePagedQuery, variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching issue timeline: %w", err)
+		}
+		items = append(items, result.Node.TimelineItems.Nodes...)
+		if !result.Node.TimelineItems.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return nil, err
+		}
+		variables["after"] = result.Node.TimelineItems.PageInfo.EndCursor
+	}
+	return items, nil
+}
+
+// setIssueChangeLog sets the changelog on the issue from the timeline, fetching any of the timeline which wasn't on the
+// first page
+func (g *GithubIntegration) setIssueChangeLog(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, issue *sdk.WorkIssue, timeline issueTimelineItems) error {
+	// leave the changelog empty if we can't fetch all of it
+	issue.ChangeLog = make([]sdk.WorkIssueChangeLog, 0)
+	nodes := timeline.Nodes
+	if timeline.PageInfo.HasNextPage {
+		more, err := g.fetchIssueTimeline(logger, client, control, issue.RefID, timeline.PageInfo.EndCursor)
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, more...)
+	}
+	changelog := make([]sdk.WorkIssueChangeLog, 0)
+	for _, node := range nodes {
+		change, err := node.ToModel(logger, userManager, issue.CustomerID)
+		if err != nil {
+			return err
+		}
+		if change != nil {
+			changelog = append(changelog, *change)
+		}
+	}
+	issue.ChangeLog = changelog
+	return nil
+}
@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueTimelineItemToModel(t *testing.T) {
+	assert := assert.New(t)
+	ts := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	change, err := issueTimelineItem{Type: "ReopenedEvent", ID: "1", CreatedAt: ts}.ToModel(nil, nil, "1234")
+	assert.NoError(err)
+	assert.Equal(sdk.WorkIssueChangeLogFieldStatus, change.Field)
+	assert.Equal("Closed", change.FromString)
+	assert.Equal("Open", change.ToString)
+	assert.Equal(sdk.NewWorkIssueStatusID("1234", refType, "Open"), change.To)
+	assert.Equal("1", change.RefID)
+
+	change, err = issueTimelineItem{Type: "UnlabeledEvent", ID: "2", Label: &nameProp{Name: "bug"}}.ToModel(nil, nil, "1234")
+	assert.NoError(err)
+	assert.Equal(sdk.WorkIssueChangeLogFieldTags, change.Field)
+	assert.Equal("bug", change.From)
+	assert.Empty(change.To)
+
+	change, err = issueTimelineItem{Type: "RenamedTitleEvent", ID: "3", PreviousTitle: "a", CurrentTitle: "b"}.ToModel(nil, nil, "1234")
+	assert.NoError(err)
+	assert.Equal(sdk.WorkIssueChangeLogFieldTitle, change.Field)
+	assert.Equal("a", change.From)
+	assert.Equal("b", change.To)
+
+	change, err = issueTimelineItem{Type: "LabeledEvent", ID: "4"}.ToModel(nil, nil, "1234")
+	assert.NoError(err)
+	assert.Nil(change)
+}
+
+func TestSetIssueChangeLogError(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	issue := &sdk.WorkIssue{RefID: "I_1", CustomerID: "1234"}
+	timeline := issueTimelineItems{Nodes: []issueTimelineItem{{Type: "ReopenedEvent", ID: "1"}}}
+	timeline.PageInfo.HasNextPage = true
+	timeline.PageInfo.EndCursor = "t1"
+	client := &mockPagedGraphQLClient{}
+	assert.Error(g.setIssueChangeLog(sdk.NewNoOpTestLogger(), client, nil, &mockControl{}, issue, timeline))
+	assert.NotNil(issue.ChangeLog)
+	assert.Empty(issue.ChangeLog)
+}
@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+// checkpointExpiry is how long a checkpoint for an unfinished historical export is kept around
+const checkpointExpiry = time.Hour * 24 * 7
+
+// checkpointStage is the progress of one stage of a repo export
+type checkpointStage struct {
+	Completed bool   `json:"completed"`
+	Cursor    string `json:"cursor,omitempty"` // the cursor to resume paging after
+	First     string `json:"first,omitempty"`  // the first cursor of the export, saved for incrementals once completed
+}
+
+// the types of checkpointJob
+const (
+	checkpointJobPullRequestReviews        = "pullrequest_reviews"
+	checkpointJobPullRequestReviewRequests = "pullrequest_review_requests"
+	checkpointJobPullRequestComments       = "pullrequest_comments"
+	checkpointJobPullRequestReviewThreads  = "pullrequest_review_threads"
+	checkpointJobIssueComments             = "issue_comments"
+)
+
+// checkpointJob is a job queued to page through the rest of a pull request's or issue's children. it is
+// kept in the checkpoint until it has run so that a resumed export can queue it again.
+type checkpointJob struct {
+	Type      string    `json:"type"`
+	ID        string    `json:"id"` // the pull request or issue id
+	Number    int       `json:"number"`
+	Cursor    string    `json:"cursor"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"` // the pull request updated date for review requests
+}
+
+// is returns true if o pages through the same children as the job
+func (j checkpointJob) is(o checkpointJob) bool {
+	return j.Type == o.Type && j.ID == o.ID && j.Cursor == o.Cursor
+}
+
+// repoCheckpoint is the progress of a historical export for a repo
+type repoCheckpoint struct {
+	Repo           checkpointStage `json:"repo"`
+	PullRequests   checkpointStage `json:"pullrequests"`
+	Issues         checkpointStage `json:"issues"`
+	Milestones     checkpointStage `json:"milestones"`
+	Projects       checkpointStage `json:"projects"`
+	Commits        checkpointStage `json:"commits"`
+	CommitComments checkpointStage `json:"commit_comments"`
+	Branches       checkpointStage `json:"branches"`
+	Releases       checkpointStage `json:"releases"`
+	Deployments    checkpointStage `json:"deployments"`
+	Builds         checkpointStage `json:"builds"`
+	SecurityAlerts checkpointStage `json:"security_alerts"`
+	Jobs           []checkpointJob `json:"jobs,omitempty"`
+}
+
+// Completed returns true if all the stages for the repo have been exported and their jobs have run
+func (c *repoCheckpoint) Completed() bool {
+	return len(c.Jobs) == 0 && c.Repo.Completed && c.PullRequests.Completed && c.Issues.Completed && c.Milestones.Completed && c.Projects.Completed && c.Commits.Completed && c.CommitComments.Completed && c.Branches.Completed && c.Releases.Completed && c.Deployments.Completed && c.Builds.Completed && c.SecurityAlerts.Completed
+}
+
+// exportCheckpoints persists the progress of a historical export into state so that an export which
+// is interrupted can be resumed where it stopped. a nil or disabled checkpoints is a no-op.
+// easyjson:skip
+type exportCheckpoints struct {
+	state   sdk.State
+	enabled bool
+	lock    sync.Mutex
+}
+
+func newExportCheckpoints(state sdk.State, historical bool) *exportCheckpoints {
+	return &exportCheckpoints{
+		state:   state,
+		enabled: historical,
+	}
+}
+
+func (c *exportCheckpoints) getKey(repoName string) string {
+	return "checkpoint_" + repoName
+}
+
+func (c *exportCheckpoints) load(repoName string) (*repoCheckpoint, error) {
+	var checkpoint repoCheckpoint
+	if _, err := c.state.Get(c.getKey(repoName), &checkpoint); err != nil {
+		return nil, fmt.Errorf("error fetching checkpoint for %s: %w", repoName, err)
+	}
+	return &checkpoint, nil
+}
+
+// Get returns the checkpoint for the repo
+func (c *exportCheckpoints) Get(repoName string) (*repoCheckpoint, error) {
+	if c == nil || !c.enabled {
+		return &repoCheckpoint{}, nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.load(repoName)
+}
+
+// Update will save the changes made by fn to the checkpoint for the repo
+func (c *exportCheckpoints) Update(repoName string, fn func(checkpoint *repoCheckpoint)) error {
+	if c == nil || !c.enabled {
+		return nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	checkpoint, err := c.load(repoName)
+	if err != nil {
+		return err
+	}
+	fn(checkpoint)
+	if err := c.state.SetWithExpires(c.getKey(repoName), checkpoint, checkpointExpiry); err != nil {
+		return fmt.Errorf("error saving checkpoint for %s: %w", repoName, err)
+	}
+	return nil
+}
+
+// AddJob will save the job to the checkpoint for the repo, returning false if it was already saved
+func (c *exportCheckpoints) AddJob(repoName string, j checkpointJob) (bool, error) {
+	var found bool
+	err := c.Update(repoName, func(checkpoint *repoCheckpoint) {
+		for _, existing := range checkpoint.Jobs {
+			if existing.is(j) {
+				found = true
+				return
+			}
+		}
+		checkpoint.Jobs = append(checkpoint.Jobs, j)
+	})
+	return !found, err
+}
+
+// RemoveJob will remove the job from the checkpoint for the repo once it has run
+func (c *exportCheckpoints) RemoveJob(repoName string, j checkpointJob) error {
+	return c.Update(repoName, func(checkpoint *repoCheckpoint) {
+		for i, existing := range checkpoint.Jobs {
+			if existing.is(j) {
+				checkpoint.Jobs = append(checkpoint.Jobs[:i], checkpoint.Jobs[i+1:]...)
+				return
+			}
+		}
+	})
+}
+
+// Clear will remove the checkpoints for the repos once the export has completed
+func (c *exportCheckpoints) Clear(logger sdk.Logger, repoNames []string) {
+	if c == nil || !c.enabled {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, name := range repoNames {
+		if err := c.state.Delete(c.getKey(name)); err != nil {
+			sdk.LogError(logger, "error removing checkpoint", "repo", name, "err", err)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckpointJobs(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	state := &mockState{values: make(map[string]string)}
+	checkpoints := newExportCheckpoints(state, true)
+	pipe := &mockPipe{}
+	e := &repoExport{
+		logger:      sdk.NewNoOpTestLogger(),
+		client:      &mockPagedGraphQLClient{responses: []string{`{"rateLimit":{"limit":5000,"remaining":4999},"repository":{"issue":{"comments":{"pageInfo":{"hasPreviousPage":false},"nodes":[]}}}}`}},
+		userManager: newMockUserManager(pipe),
+		checkpoints: checkpoints,
+	}
+	j := checkpointJob{Type: checkpointJobIssueComments, ID: "I_1", Number: 10, Cursor: "c1"}
+	assert.NoError(g.queueCheckpointJob(e, "pinpt/agent", "R_1", "P_1", j))
+	// queueing the same job again, such as when its stage is resumed, doesn't run it twice
+	assert.NoError(g.queueCheckpointJob(e, "pinpt/agent", "R_1", "P_1", j))
+	assert.Len(e.jobs, 1)
+	checkpoint, err := checkpoints.Get("pinpt/agent")
+	assert.NoError(err)
+	assert.Len(checkpoint.Jobs, 1)
+	checkpoint.Repo.Completed = true
+	checkpoint.PullRequests.Completed = true
+	checkpoint.Issues.Completed = true
+	checkpoint.Milestones.Completed = true
+	checkpoint.Projects.Completed = true
+	checkpoint.Commits.Completed = true
+	checkpoint.CommitComments.Completed = true
+	checkpoint.Branches.Completed = true
+	checkpoint.Releases.Completed = true
+	checkpoint.Deployments.Completed = true
+	checkpoint.Builds.Completed = true
+	checkpoint.SecurityAlerts.Completed = true
+	assert.False(checkpoint.Completed())
+	assert.NoError(e.jobs[0].run(&mockExport{}, pipe))
+	checkpoint, err = checkpoints.Get("pinpt/agent")
+	assert.NoError(err)
+	assert.Empty(checkpoint.Jobs)
+}
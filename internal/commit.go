@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"time"
+
 	"github.com/google/go-github/v32/github"
 	"github.com/pinpt/agent/v4/sdk"
 )
@@ -63,3 +65,59 @@ func (g *GithubIntegration) fromPushEvent(logger sdk.Logger, userManager *UserMa
 	}
 	return commits, nil
 }
+
+type commit struct {
+	Sha          string    `json:"sha"`
+	Message      string    `json:"message"`
+	Date         time.Time `json:"authoredDate"`
+	Additions    int64     `json:"additions"`
+	Deletions    int64     `json:"deletions"`
+	ChangedFiles int64     `json:"changedFiles"`
+	URL          string    `json:"url"`
+	Author       gitUser   `json:"author"`
+	Committer    gitUser   `json:"committer"`
+}
+
+type defaultBranchCommitsResult struct {
+	RateLimit  rateLimit `json:"rateLimit"`
+	Repository struct {
+		DefaultBranchRef *struct {
+			Name   string `json:"name"`
+			Target struct {
+				History struct {
+					TotalCount int      `json:"totalCount"`
+					PageInfo   pageInfo `json:"pageInfo"`
+					Nodes      []commit `json:"nodes"`
+				} `json:"history"`
+			} `json:"target"`
+		} `json:"defaultBranchRef"`
+	} `json:"repository"`
+}
+
+func (c commit) ToModel(logger sdk.Logger, userManager *UserManager, customerID string, repoName string, repoID string) (*sdk.SourceCodeCommit, error) {
+	commit := &sdk.SourceCodeCommit{}
+	commit.CustomerID = customerID
+	commit.RepoID = repoID
+	commit.ID = sdk.NewSourceCodeCommitID(customerID, c.Sha, refType, repoID)
+	commit.Sha = c.Sha
+	commit.Message = c.Message
+	commit.Additions = c.Additions
+	commit.Deletions = c.Deletions
+	commit.FilesChanged = c.ChangedFiles
+	commit.RefType = refType
+	commit.RefID = c.Sha
+	commit.URL = c.URL
+	commit.Identifier = repoName + "#" + c.Sha[0:7]
+	commit.AuthorRefID = c.Author.RefID(customerID)
+	commit.CommitterRefID = c.Committer.RefID(customerID)
+	commit.Active = true
+	commit.IntegrationInstanceID = sdk.StringPointer(userManager.instanceid)
+	sdk.ConvertTimeToDateModel(c.Date, &commit.CreatedDate)
+	if err := userManager.emitGitUser(logger, c.Author); err != nil {
+		return nil, err
+	}
+	if err := userManager.emitGitUser(logger, c.Committer); err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+// commitComment adds the line of an inline comment which go-github doesn't have
+// easyjson:skip
+type commitComment struct {
+	github.RepositoryComment
+	Line *int64 `json:"line,omitempty"`
+}
+
+// commitCommentEvent is the commit_comment webhook, parsed ourselves so that we get the line of the comment
+// easyjson:skip
+type commitCommentEvent struct {
+	Action  string             `json:"action"`
+	Comment *commitComment     `json:"comment"`
+	Repo    *github.Repository `json:"repository"`
+}
+
+func (c commitComment) ToModel(logger sdk.Logger, userManager *UserManager, customerID string, repoID string) (*sdk.SourceCodeCommitComment, error) {
+	comment := &sdk.SourceCodeCommitComment{}
+	comment.CustomerID = customerID
+	comment.RefType = refType
+	comment.RefID = c.GetNodeID()
+	comment.RepoID = repoID
+	comment.ID = sdk.NewSourceCodeCommitCommentID(customerID, comment.RefID, refType, repoID)
+	comment.IntegrationInstanceID = sdk.StringPointer(userManager.instanceid)
+	comment.Sha = c.GetCommitID()
+	comment.CommitID = sdk.NewSourceCodeCommitID(customerID, comment.Sha, refType, repoID)
+	comment.Body = toHTML(c.GetBody())
+	comment.URL = c.GetHTMLURL()
+	comment.Path = c.GetPath()
+	comment.Position = int64(c.GetPosition())
+	if c.Line != nil {
+		comment.Line = *c.Line
+	}
+	comment.Active = true
+	sdk.ConvertTimeToDateModel(c.GetCreatedAt(), &comment.CreatedDate)
+	sdk.ConvertTimeToDateModel(c.GetUpdatedAt(), &comment.UpdatedDate)
+	if c.User != nil {
+		author := userToAuthor(c.User)
+		comment.UserRefID = author.RefID(customerID)
+		if err := userManager.emitAuthor(logger, author); err != nil {
+			return nil, err
+		}
+	}
+	return comment, nil
+}
+
+func (g *GithubIntegration) getCommitCommentsKey(repoName string) string {
+	return fmt.Sprintf("commit_comments_%s", repoName)
+}
+
+// exportCommitComments will export the comments on the commits in the repo. the api returns the oldest comments first
+// so an incremental starts at the last page and works backwards until it finds a comment from before the previous export.
+// this means an incremental misses an edit to a comment older than that. we rely on the commit_comment webhook to keep
+// the comments current but github only sends it when a comment is created, so those edits wait for a historical export
+func (g *GithubIntegration) exportCommitComments(logger sdk.Logger, httpclient sdk.HTTPClient, userManager *UserManager, errs *exportErrors, export sdk.Export, repoName string, repoID string, historical bool) error {
+	state := export.State()
+	pipe := export.Pipe()
+	started := time.Now()
+	var since time.Time
+	if !historical {
+		state.Get(g.getCommitCommentsKey(repoName), &since)
+	}
+	endpoint := "/repos/" + repoName + "/comments"
+	params := url.Values{}
+	params.Set("per_page", "100")
+	rel := "next"
+	var count int
+	for params != nil {
+		sdk.LogDebug(logger, "running fetch commit comments", "name", repoName, "params", params.Encode())
+		var comments []commitComment
+		resp, err := httpclient.Get(&comments, sdk.WithEndpoint(endpoint), sdk.WithGetQueryParameters(params))
+		if err != nil {
+			return fmt.Errorf("error fetching commit comments for %s: %w", repoName, err)
+		}
+		if !since.IsZero() && rel == "next" {
+			// start again from the last page
+			if last := pageLinkParams(resp, "last"); last != nil {
+				params, rel = last, "prev"
+				continue
+			}
+			rel = "prev"
+		}
+		params = pageLinkParams(resp, rel)
+		for i := len(comments) - 1; i >= 0; i-- {
+			c := comments[i]
+			if c.GetCreatedAt().Before(since) && c.GetUpdatedAt().Before(since) {
+				// the comments are in created order so an edit to an older comment is missed, see above
+				params = nil
+				break
+			}
+			comment, err := c.ToModel(logger, userManager, export.CustomerID(), repoID)
+			if err != nil {
+				errs.Add(repoName, "commit comment", strconv.FormatInt(c.GetID(), 10), err)
+				continue
+			}
+			if err := pipe.Write(comment); err != nil {
+				return err
+			}
+			count++
+		}
+	}
+	sdk.LogDebug(logger, "fetched commit comments", "name", repoName, "count", count)
+	return state.Set(g.getCommitCommentsKey(repoName), started)
+}
+
+// fromCommitCommentEvent returns the comment which was changed
+func (g *GithubIntegration) fromCommitCommentEvent(logger sdk.Logger, userManager *UserManager, customerID string, event *commitCommentEvent) (*sdk.SourceCodeCommitComment, error) {
+	repoID := sdk.NewSourceCodeRepoID(customerID, event.Repo.GetNodeID(), refType)
+	comment, err := event.Comment.ToModel(logger, userManager, customerID, repoID)
+	if err != nil {
+		return nil, err
+	}
+	if event.Action == "deleted" {
+		comment.Active = false
+	}
+	return comment, nil
+}
@@ -0,0 +1,20 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCommitCommentWebHook(t *testing.T) {
+	assert := assert.New(t)
+	obj, err := parseWebHook("commit_comment", []byte(`{"action":"created","comment":{"id":1,"node_id":"abc","commit_id":"123","path":"main.go","position":4,"line":12,"body":"nice"},"repository":{"full_name":"pinpt/agent","node_id":"repo"}}`))
+	assert.NoError(err)
+	event, ok := obj.(*commitCommentEvent)
+	assert.True(ok)
+	assert.Equal("created", event.Action)
+	assert.Equal("123", event.Comment.GetCommitID())
+	assert.Equal("main.go", event.Comment.GetPath())
+	assert.Equal(4, event.Comment.GetPosition())
+	assert.EqualValues(12, *event.Comment.Line)
+}
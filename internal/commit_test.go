@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommitToModel(t *testing.T) {
+	assert := assert.New(t)
+	var c commit
+	assert.NoError(json.Unmarshal([]byte(`{"sha":"8d6e8e8b1dbd1c2e3b4f5a6b7c8d9e0f1a2b3c4d","message":"fix the build","authoredDate":"2020-10-01T12:00:00Z","additions":10,"deletions":4,"changedFiles":2,"url":"https://github.com/pinpt/agent/commit/8d6e8e8","author":{"name":"Jeff","email":"jeff@pinpoint.com","user":{"type":"User","id":"U_1","login":"jhaynie"}},"committer":{"name":"GitHub","email":"noreply@github.com"}}`), &c))
+	pipe := &mockPipe{}
+	commit, err := c.ToModel(sdk.NewNoOpTestLogger(), newMockUserManager(pipe), "1234", "pinpt/agent", "R_1")
+	assert.NoError(err)
+	assert.Equal("8d6e8e8b1dbd1c2e3b4f5a6b7c8d9e0f1a2b3c4d", commit.RefID)
+	assert.Equal("pinpt/agent#8d6e8e8", commit.Identifier)
+	assert.Equal("R_1", commit.RepoID)
+	assert.EqualValues(10, commit.Additions)
+	assert.EqualValues(4, commit.Deletions)
+	assert.EqualValues(2, commit.FilesChanged)
+	assert.Equal("U_1", commit.AuthorRefID)
+	assert.Equal(sdk.Hash("1234", "noreply@github.com"), commit.CommitterRefID)
+	assert.True(commit.Active)
+	author := pipe.records[0].(*sdk.SourceCodeUser)
+	assert.Equal("U_1", author.RefID)
+	assert.Equal("Jeff", author.Name)
+}
+
+func TestFetchDefaultBranchCommits(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	client := &mockPagedGraphQLClient{
+		responses: []string{
+			`{"rateLimit":{"limit":5000,"remaining":4999},"repository":{"defaultBranchRef":{"name":"main","target":{"history":{"pageInfo":{"hasNextPage":true,"endCursor":"h1"},"nodes":[{"sha":"1111111aaaa","additions":1,"author":{"user":{"type":"User","id":"U_1"}},"committer":{"user":{"type":"User","id":"U_1"}}}]}}}}}`,
+			`{"rateLimit":{"limit":5000,"remaining":4998},"repository":{"defaultBranchRef":{"name":"main","target":{"history":{"pageInfo":{"hasNextPage":false,"endCursor":"h2"},"nodes":[{"sha":"2222222bbbb","deletions":2,"author":{"user":{"type":"User","id":"U_2"}},"committer":{"user":{"type":"User","id":"U_1"}}}]}}}}}`,
+		},
+	}
+	pipe := &mockPipe{}
+	state := &mockState{}
+	assert.NoError(state.Set(g.getCommitsKey("pinpt/agent"), "2020-10-01T00:00:00Z"))
+	export := &mockExport{state: state, pipe: pipe}
+	var errs exportErrors
+	assert.NoError(g.fetchDefaultBranchCommits(sdk.NewNoOpTestLogger(), client, newMockUserManager(pipe), nil, &errs, export, "pinpt/agent", "R_1", false))
+	assert.Equal(0, errs.Len())
+	assert.Len(client.variables, 2)
+	assert.Equal("2020-10-01T00:00:00Z", client.variables[0]["since"])
+	assert.Nil(client.variables[0]["after"])
+	assert.Equal("h1", client.variables[1]["after"])
+	commits := make([]string, 0)
+	for _, record := range pipe.records {
+		if c, ok := record.(*sdk.SourceCodeCommit); ok {
+			commits = append(commits, c.Sha)
+		}
+	}
+	assert.Equal([]string{"1111111aaaa", "2222222bbbb"}, commits)
+	var since string
+	_, err := state.Get(g.getCommitsKey("pinpt/agent"), &since)
+	assert.NoError(err)
+	assert.NotEqual("2020-10-01T00:00:00Z", since)
+}
@@ -0,0 +1,370 @@
+package internal
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+// maxCommitsPerQuery is the number of commits looked up in one query
+const maxCommitsPerQuery = 25
+
+type deploymentStatus struct {
+	State          string    `json:"state"`
+	Description    string    `json:"description"`
+	CreatedAt      time.Time `json:"createdAt"`
+	LogURL         string    `json:"logUrl"`
+	EnvironmentURL string    `json:"environmentUrl"`
+	Creator        *author   `json:"creator"`
+}
+
+type deployment struct {
+	ID          string    `json:"id"`
+	Environment string    `json:"environment"`
+	Description string    `json:"description"`
+	State       string    `json:"state"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	CommitOid   string    `json:"commitOid"`
+	Ref         *struct {
+		Name string `json:"name"`
+	} `json:"ref"`
+	Creator  *author `json:"creator"`
+	Statuses struct {
+		Nodes []deploymentStatus `json:"nodes"`
+	} `json:"statuses"`
+}
+
+type deploymentsResult struct {
+	RateLimit  rateLimit `json:"rateLimit"`
+	Repository struct {
+		Deployments struct {
+			PageInfo pageInfo     `json:"pageInfo"`
+			Nodes    []deployment `json:"nodes"`
+		} `json:"deployments"`
+	} `json:"repository"`
+}
+
+type compareResult struct {
+	TotalCommits int `json:"total_commits"`
+	Commits      []struct {
+		Sha string `json:"sha"`
+	} `json:"commits"`
+}
+
+func (d deployment) ToModel(logger sdk.Logger, userManager *UserManager, customerID string, repoID string, shas []string, pullRequestRefIDs []string) (*sdk.SourceCodeDeployment, error) {
+	deployment := &sdk.SourceCodeDeployment{}
+	deployment.CustomerID = customerID
+	deployment.RefType = refType
+	deployment.RefID = d.ID
+	deployment.RepoID = repoID
+	deployment.ID = sdk.NewSourceCodeDeploymentID(customerID, d.ID, refType, repoID)
+	deployment.IntegrationInstanceID = sdk.StringPointer(userManager.instanceid)
+	deployment.Environment = d.Environment
+	deployment.Description = d.Description
+	deployment.State = strings.ToLower(d.State)
+	deployment.Sha = d.CommitOid
+	deployment.CommitID = sdk.NewSourceCodeCommitID(customerID, d.CommitOid, refType, repoID)
+	deployment.Active = true
+	if d.Ref != nil {
+		deployment.Ref = d.Ref.Name
+	}
+	sdk.ConvertTimeToDateModel(d.CreatedAt, &deployment.CreatedDate)
+	sdk.ConvertTimeToDateModel(d.UpdatedAt, &deployment.UpdatedDate)
+	if d.Creator != nil {
+		deployment.CreatorRefID = d.Creator.RefID(customerID)
+		if err := userManager.emitAuthor(logger, *d.Creator); err != nil {
+			return nil, err
+		}
+	}
+	deployment.Statuses = make([]sdk.SourceCodeDeploymentStatuses, 0)
+	for _, s := range d.Statuses.Nodes {
+		status := sdk.SourceCodeDeploymentStatuses{
+			State:          strings.ToLower(s.State),
+			Description:    s.Description,
+			LogURL:         s.LogURL,
+			EnvironmentURL: s.EnvironmentURL,
+		}
+		sdk.ConvertTimeToDateModel(s.CreatedAt, &status.CreatedDate)
+		if s.Creator != nil {
+			status.CreatorRefID = s.Creator.RefID(customerID)
+			if err := userManager.emitAuthor(logger, *s.Creator); err != nil {
+				return nil, err
+			}
+		}
+		deployment.Statuses = append(deployment.Statuses, status)
+	}
+	deployment.CommitShas = shas
+	deployment.CommitIds = make([]string, 0)
+	for _, sha := range shas {
+		deployment.CommitIds = append(deployment.CommitIds, sdk.NewSourceCodeCommitID(customerID, sha, refType, repoID))
+	}
+	deployment.PullRequestIds = make([]string, 0)
+	for _, refID := range pullRequestRefIDs {
+		deployment.PullRequestIds = append(deployment.PullRequestIds, sdk.NewSourceCodePullRequestID(customerID, refID, refType, repoID))
+	}
+	return deployment, nil
+}
+
+func (g *GithubIntegration) getDeploymentsKey(repoName string) string {
+	return fmt.Sprintf("deployments_%s", repoName)
+}
+
+func (g *GithubIntegration) getDeploymentShasKey(repoName string) string {
+	return fmt.Sprintf("deployment_shas_%s", repoName)
+}
+
+// fetchShippedCommits returns the shas of the commits after base up to and including head, or just head if there is no base
+func (g *GithubIntegration) fetchShippedCommits(logger sdk.Logger, httpclient sdk.HTTPClient, repoName string, base string, head string) ([]string, error) {
+	if base == "" || base == head {
+		return []string{head}, nil
+	}
+	endpoint := "/repos/" + repoName + "/compare/" + base + "..." + head
+	params := url.Values{}
+	params.Set("per_page", "100")
+	shas := make([]string, 0)
+	var total int
+	for params != nil {
+		var result compareResult
+		resp, err := httpclient.Get(&result, sdk.WithEndpoint(endpoint), sdk.WithGetQueryParameters(params))
+		if err != nil {
+			return nil, fmt.Errorf("error comparing %s...%s for %s: %w", base, head, repoName, err)
+		}
+		for _, commit := range result.Commits {
+			shas = append(shas, commit.Sha)
+		}
+		total = result.TotalCommits
+		params = pageLinkParams(resp, "next")
+	}
+	if len(shas) < total {
+		// github limits how many commits a compare will return
+		sdk.LogWarn(logger, "shipped commits are truncated", "name", repoName, "base", base, "head", head, "count", len(shas), "total", total)
+	}
+	return shas, nil
+}
+
+// mergedPullRequestsQuery builds the query to fetch the pull requests associated with each commit
+func mergedPullRequestsQuery(count int) string {
+	var args, objects strings.Builder
+	for i := 0; i < count; i++ {
+		args.WriteString(fmt.Sprintf(", $c%d: GitObjectID!", i))
+		objects.WriteString(fmt.Sprintf(`
+		c%d: object(oid: $c%d) {
+			...on Commit {
+				associatedPullRequests(first: 5) {
+					nodes {
+						id
+						merged
+					}
+				}
+			}
+		}`, i, i))
+	}
+	return fmt.Sprintf(`query GetMergedPullRequests($name: String!, $owner: String!%s) {
+	repository(name: $name, owner: $owner) {%s
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}`, args.String(), objects.String())
+}
+
+// fetchMergedPullRequests returns the ref ids of the merged pull requests for the commits
+func (g *GithubIntegration) fetchMergedPullRequests(logger sdk.Logger, exec *queryExecutor, repoName string, shas []string) ([]string, error) {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	found := make(map[string]bool)
+	refIDs := make([]string, 0)
+	for offset := 0; offset < len(shas); offset += maxCommitsPerQuery {
+		end := offset + maxCommitsPerQuery
+		if end > len(shas) {
+			end = len(shas)
+		}
+		batch := shas[offset:end]
+		variables := map[string]interface{}{
+			"owner": repoOwner,
+			"name":  repoLogin,
+		}
+		for i, sha := range batch {
+			variables[fmt.Sprintf("c%d", i)] = sha
+		}
+		var result struct {
+			RateLimit  rateLimit `json:"rateLimit"`
+			Repository map[string]*struct {
+				AssociatedPullRequests struct {
+					Nodes []struct {
+						ID     string `json:"id"`
+						Merged bool   `json:"merged"`
+					} `json:"nodes"`
+				} `json:"associatedPullRequests"`
+			} `json:"repository"`
+		}
+		if err := exec.Query(mergedPullRequestsQuery(len(batch)), variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching pull requests for commits in %s: %w", repoName, err)
+		}
+		for i := range batch {
+			commit := result.Repository[fmt.Sprintf("c%d", i)]
+			if commit == nil {
+				continue
+			}
+			for _, pr := range commit.AssociatedPullRequests.Nodes {
+				if pr.Merged && !found[pr.ID] {
+					found[pr.ID] = true
+					refIDs = append(refIDs, pr.ID)
+				}
+			}
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return nil, err
+		}
+	}
+	return refIDs, nil
+}
+
+// deploymentToModel returns the deployment along with the commits and merged pull requests which it shipped since the
+// previous deployment to the same environment. if the commits can't be compared the deployment is still returned with
+// only the deployed commit along with the compare error
+func (g *GithubIntegration) deploymentToModel(logger sdk.Logger, exec *queryExecutor, httpclient sdk.HTTPClient, userManager *UserManager, customerID string, repoName string, repoID string, node deployment, previousSha string) (*sdk.SourceCodeDeployment, error) {
+	shas, compareErr := g.fetchShippedCommits(logger, httpclient, repoName, previousSha, node.CommitOid)
+	if compareErr != nil {
+		if isFatalExportError(compareErr) {
+			return nil, compareErr
+		}
+		// the previous sha may have been force pushed away so fall back to the deployed commit
+		shas = []string{node.CommitOid}
+	}
+	pullRequestRefIDs, err := g.fetchMergedPullRequests(logger, exec, repoName, shas)
+	if err != nil {
+		return nil, err
+	}
+	deployment, err := node.ToModel(logger, userManager, customerID, repoID, shas, pullRequestRefIDs)
+	if err != nil {
+		return nil, err
+	}
+	return deployment, compareErr
+}
+
+// exportDeployments will export the deployments for the repo. in an incremental only the deployments created since the
+// previous export are exported, updates to older deployments come from the deployment_status webhook
+func (g *GithubIntegration) exportDeployments(logger sdk.Logger, client sdk.GraphQLClient, httpclient sdk.HTTPClient, userManager *UserManager, errs *exportErrors, export sdk.Export, repoName string, repoID string, historical bool) error {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	customerID := export.CustomerID()
+	pipe := export.Pipe()
+	state := export.State()
+	exec := g.newQueryExecutor(logger, client, export)
+	started := time.Now()
+	var since time.Time
+	// the sha of the latest deployment to each environment from the previous export
+	previousShas := make(map[string]string)
+	if !historical {
+		state.Get(g.getDeploymentsKey(repoName), &since)
+		state.Get(g.getDeploymentShasKey(repoName), &previousShas)
+	}
+	variables := map[string]interface{}{
+		"first": defaultPageSize,
+		"owner": repoOwner,
+		"name":  repoLogin,
+	}
+	nodes := make([]deployment, 0)
+	for {
+		sdk.LogDebug(logger, "running fetch deployments", "name", repoName, "after", variables["after"])
+		var result deploymentsResult
+		if err := exec.Query(deploymentsQuery, variables, &result); err != nil {
+			return fmt.Errorf("error fetching deployments for %s: %w", repoName, err)
+		}
+		done := !result.Repository.Deployments.PageInfo.HasNextPage
+		for _, node := range result.Repository.Deployments.Nodes {
+			if node.CreatedAt.Before(since) {
+				done = true
+				break
+			}
+			nodes = append(nodes, node)
+		}
+		if done {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return err
+		}
+		variables["after"] = result.Repository.Deployments.PageInfo.EndCursor
+	}
+	// the deployments are newest first so process them oldest first to know what the previous deployment shipped
+	for i := len(nodes) - 1; i >= 0; i-- {
+		node := nodes[i]
+		deployment, err := g.deploymentToModel(logger, exec, httpclient, userManager, customerID, repoName, repoID, node, previousShas[node.Environment])
+		if err != nil {
+			if isFatalExportError(err) {
+				return err
+			}
+			errs.Add(repoName, "deployment", node.ID, err)
+			if deployment == nil {
+				continue
+			}
+		}
+		if err := pipe.Write(deployment); err != nil {
+			return err
+		}
+		previousShas[node.Environment] = node.CommitOid
+	}
+	if err := state.Set(g.getDeploymentShasKey(repoName), previousShas); err != nil {
+		return err
+	}
+	return state.Set(g.getDeploymentsKey(repoName), started)
+}
+
+// fetchDeployment returns the deployment with the id along with what it shipped since the previous deployment to the environment
+func (g *GithubIntegration) fetchDeployment(logger sdk.Logger, client sdk.GraphQLClient, httpclient sdk.HTTPClient, userManager *UserManager, control sdk.Control, customerID string, repoName string, repoRefID string, deploymentRefID string, environment string) (*sdk.SourceCodeDeployment, error) {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	repoID := sdk.NewSourceCodeRepoID(customerID, repoRefID, refType)
+	exec := g.newQueryExecutor(logger, client, control)
+	variables := map[string]interface{}{
+		"first":        defaultPageSize,
+		"owner":        repoOwner,
+		"name":         repoLogin,
+		"environments": []string{environment},
+	}
+	var found *deployment
+	// empty if this is the first deployment to the environment
+	var previousSha string
+	for {
+		var result deploymentsResult
+		if err := exec.Query(deploymentsQuery, variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching deployments for %s: %w", repoName, err)
+		}
+		for _, node := range result.Repository.Deployments.Nodes {
+			if found != nil {
+				// the deployments are newest first so this is the previous deployment
+				previousSha = node.CommitOid
+				break
+			}
+			if node.ID == deploymentRefID {
+				n := node
+				found = &n
+			}
+		}
+		if previousSha != "" || !result.Repository.Deployments.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return nil, err
+		}
+		variables["after"] = result.Repository.Deployments.PageInfo.EndCursor
+	}
+	if found == nil {
+		sdk.LogInfo(logger, "deployment not found", "id", deploymentRefID, "repo", repoName)
+		return nil, nil
+	}
+	deployment, err := g.deploymentToModel(logger, exec, httpclient, userManager, customerID, repoName, repoID, *found, previousSha)
+	if err != nil {
+		if deployment == nil {
+			return nil, err
+		}
+		sdk.LogWarn(logger, "error fetching the commits shipped by the deployment, using the deployed commit", "id", deploymentRefID, "repo", repoName, "err", err)
+	}
+	return deployment, nil
+}
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchShippedCommits(t *testing.T) {
+	assert := assert.New(t)
+	var calls int
+	client := &MockHTTPClient{
+		Callback: func(method string, data io.Reader, out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+			calls++
+			resp := &sdk.HTTPResponse{StatusCode: 200, Headers: http.Header{}}
+			if calls == 1 {
+				resp.Headers.Set("Link", `<https://api.github.com/repositories/1/compare/base...b?per_page=100&page=2>; rel="next"`)
+				return resp, json.Unmarshal([]byte(`{"total_commits":3,"commits":[{"sha":"a"},{"sha":"b"}]}`), out)
+			}
+			return resp, json.Unmarshal([]byte(`{"total_commits":3,"commits":[{"sha":"c"}]}`), out)
+		},
+	}
+	g := &GithubIntegration{}
+	logger := sdk.NewNoOpTestLogger()
+	shas, err := g.fetchShippedCommits(logger, client, "pinpt/agent", "", "b")
+	assert.NoError(err)
+	assert.Equal([]string{"b"}, shas)
+	assert.Equal(0, calls)
+
+	shas, err = g.fetchShippedCommits(logger, client, "pinpt/agent", "base", "b")
+	assert.NoError(err)
+	assert.Equal([]string{"a", "b", "c"}, shas)
+	assert.Equal(2, calls)
+}
+
+func TestDeploymentToModelCompareError(t *testing.T) {
+	assert := assert.New(t)
+	httpclient := &MockHTTPClient{
+		Callback: func(method string, data io.Reader, out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+			return &sdk.HTTPResponse{StatusCode: http.StatusNotFound}, errors.New("Not Found")
+		},
+	}
+	client := &mockPagedGraphQLClient{
+		responses: []string{
+			`{"rateLimit":{"limit":5000,"remaining":4999},"repository":{"c0":{"associatedPullRequests":{"nodes":[{"id":"PR_1","merged":true}]}}}}`,
+		},
+	}
+	g := &GithubIntegration{}
+	logger := sdk.NewNoOpTestLogger()
+	pipe := &mockPipe{}
+	node := deployment{ID: "D_1", Environment: "production", CommitOid: "b"}
+	deployment, err := g.deploymentToModel(logger, g.newQueryExecutor(logger, client, &mockControl{}), httpclient, newMockUserManager(pipe), "1234", "pinpt/agent", "R_1", node, "gone")
+	assert.Error(err)
+	assert.NotNil(deployment)
+	assert.Equal([]string{"b"}, deployment.CommitShas)
+	assert.Len(deployment.PullRequestIds, 1)
+	assert.Equal("b", client.variables[0]["c0"])
+}
@@ -0,0 +1,277 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+const (
+	defaultMaxRetries  = 10
+	defaultMinPageSize = 10
+	backoffBase        = time.Second * 2
+	backoffMax         = time.Minute * 2
+	abuseBackoffMin    = time.Minute
+)
+
+// sleep is a variable so that tests don't have to wait
+var sleep = time.Sleep
+
+type queryErrorType int
+
+const (
+	queryErrorUnknown queryErrorType = iota
+	queryErrorRateLimit
+	queryErrorAbuse
+	queryErrorServer
+	queryErrorAuth
+	queryErrorNotFound
+	queryErrorSAML
+	queryErrorForbidden
+)
+
+func (t queryErrorType) String() string {
+	switch t {
+	case queryErrorRateLimit:
+		return "rate limit"
+	case queryErrorAbuse:
+		return "secondary rate limit"
+	case queryErrorServer:
+		return "server error"
+	case queryErrorAuth:
+		return "authorization error"
+	case queryErrorNotFound:
+		return "not found"
+	case queryErrorSAML:
+		return "saml enforcement"
+	case queryErrorForbidden:
+		return "forbidden"
+	}
+	return "unknown error"
+}
+
+// queryError is an error returned from GitHub which has been classified by type
+// easyjson:skip
+type queryError struct {
+	Type       queryErrorType
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *queryError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Type, e.Err)
+}
+
+func (e *queryError) Unwrap() error {
+	return e.Err
+}
+
+// Retryable returns true if the error is transient and the query can be tried again
+func (e *queryError) Retryable() bool {
+	switch e.Type {
+	case queryErrorRateLimit, queryErrorAbuse, queryErrorServer:
+		return true
+	}
+	return false
+}
+
+func containsAny(val string, substrs ...string) bool {
+	for _, s := range substrs {
+		if strings.Contains(val, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// classifyError will turn an error returned from a GitHub request into a *queryError
+func classifyError(err error) *queryError {
+	if err == nil {
+		return nil
+	}
+	var qerr *queryError
+	if errors.As(err, &qerr) {
+		return qerr
+	}
+	if ok, retry := sdk.IsRateLimitError(err); ok {
+		return &queryError{Type: queryErrorRateLimit, RetryAfter: retry, Err: err}
+	}
+	msg := strings.ToLower(err.Error())
+	if ok, status, _ := sdk.IsHTTPError(err); ok {
+		switch {
+		case status == http.StatusUnauthorized:
+			return &queryError{Type: queryErrorAuth, Err: err}
+		case status == http.StatusForbidden && strings.Contains(msg, "saml"):
+			return &queryError{Type: queryErrorSAML, Err: err}
+		case status == http.StatusForbidden && containsAny(msg, "abuse detection", "secondary rate limit"):
+			return &queryError{Type: queryErrorAbuse, Err: err}
+		case status == http.StatusForbidden && strings.Contains(msg, "rate limit"):
+			return &queryError{Type: queryErrorRateLimit, Err: err}
+		case status == http.StatusForbidden:
+			return &queryError{Type: queryErrorForbidden, Err: err}
+		case status == http.StatusNotFound:
+			return &queryError{Type: queryErrorNotFound, Err: err}
+		case status >= http.StatusInternalServerError:
+			return &queryError{Type: queryErrorServer, Err: err}
+		}
+	}
+	switch {
+	case strings.Contains(msg, "saml"):
+		return &queryError{Type: queryErrorSAML, Err: err}
+	case containsAny(msg, "abuse detection", "secondary rate limit"):
+		return &queryError{Type: queryErrorAbuse, Err: err}
+	case containsAny(msg, "api rate limit exceeded", "rate limited"):
+		return &queryError{Type: queryErrorRateLimit, Err: err}
+	case containsAny(msg, "bad credentials", "requires authentication"):
+		return &queryError{Type: queryErrorAuth, Err: err}
+	case strings.Contains(msg, "resource not accessible"):
+		return &queryError{Type: queryErrorForbidden, Err: err}
+	case containsAny(msg, "could not resolve to", "not found"):
+		return &queryError{Type: queryErrorNotFound, Err: err}
+	case containsAny(msg, "something went wrong while executing your query", "eof", "bad gateway", "service unavailable", "timeout", "connection reset"):
+		return &queryError{Type: queryErrorServer, Err: err}
+	}
+	return &queryError{Type: queryErrorUnknown, Err: err}
+}
+
+// isQueryErrorType returns true if the error is classified as type t
+func isQueryErrorType(err error, t queryErrorType) bool {
+	if err == nil {
+		return false
+	}
+	return classifyError(err).Type == t
+}
+
+// backoff returns a capped exponential backoff duration with jitter for the attempt (starting at 1)
+func backoff(attempt int) time.Duration {
+	d := backoffMax
+	if attempt < 16 {
+		if v := backoffBase << uint(attempt-1); v < backoffMax {
+			d = v
+		}
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// queryExecutor runs GraphQL queries against GitHub, retrying transient errors with
+// backoff and shrinking the page size of the query when GitHub can't keep up
+// easyjson:skip
+type queryExecutor struct {
+	logger     sdk.Logger
+	client     sdk.GraphQLClient
+	control    sdk.Control
+	budget     *rateLimitBudget
+	lastQuery  string
+	maxRetries int
+}
+
+func (g *GithubIntegration) newQueryExecutor(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control) *queryExecutor {
+	e := &queryExecutor{
+		logger:     logger,
+		client:     client,
+		control:    control,
+		maxRetries: defaultMaxRetries,
+	}
+	if b, ok := client.(rateLimitBudgeted); ok {
+		e.budget = b.getBudget()
+	}
+	return e
+}
+
+func (e *queryExecutor) query(query string, variables map[string]interface{}, out interface{}) error {
+	e.lastQuery = query
+	return e.client.Query(query, variables, out)
+}
+
+// Query will run the query, retrying up to the retry budget when the error is transient. If the
+// variables contain a "first" page size, it will be reduced when GitHub returns a server error.
+func (e *queryExecutor) Query(query string, variables map[string]interface{}, out interface{}) error {
+	var attempt int
+	for {
+		err := e.query(query, variables, out)
+		if err == nil {
+			return nil
+		}
+		qerr := classifyError(err)
+		if !qerr.Retryable() {
+			return qerr
+		}
+		attempt++
+		if attempt > e.maxRetries {
+			return fmt.Errorf("failed after retrying %d times: %w", e.maxRetries, qerr)
+		}
+		if qerr.Type == queryErrorServer {
+			e.shrinkPageSize(variables)
+		}
+		if err := e.pause(qerr, attempt); err != nil {
+			return err
+		}
+	}
+}
+
+// shrinkPageSize will halve the page size to see if this will help GitHub return a result
+func (e *queryExecutor) shrinkPageSize(variables map[string]interface{}) {
+	first, ok := variables["first"].(int)
+	if !ok || first <= defaultMinPageSize {
+		return
+	}
+	first = first / 2
+	if first < defaultMinPageSize {
+		first = defaultMinPageSize
+	}
+	sdk.LogDebug(e.logger, "reducing page size", "first", first)
+	variables["first"] = first
+}
+
+func (e *queryExecutor) pause(qerr *queryError, attempt int) error {
+	var d time.Duration
+	switch qerr.Type {
+	case queryErrorRateLimit:
+		d = qerr.RetryAfter
+		if d <= 0 {
+			d = backoff(attempt)
+		}
+	case queryErrorAbuse:
+		// we need to try and back off at least 1min + some randomized number of additional ms
+		d = qerr.RetryAfter
+		if d < abuseBackoffMin {
+			d = abuseBackoffMin + time.Millisecond*time.Duration(rand.Int63n(500))
+		}
+	default:
+		d = backoff(attempt)
+	}
+	sdk.LogInfo(e.logger, "retryable error detected, will pause", "type", qerr.Type.String(), "attempt", attempt, "until", time.Now().Add(d), "err", qerr.Err)
+	if err := e.control.Paused(time.Now().Add(d)); err != nil {
+		return err
+	}
+	sleep(d)
+	sdk.LogInfo(e.logger, "retryable error resumed", "type", qerr.Type.String())
+	return e.control.Resumed()
+}
+
+// checkRateLimit will pause until the rate limit resets if the rate limit returned by a query says we should.
+// if the client shares a rate limit budget, the budget is updated instead and will slow down the next query.
+func (e *queryExecutor) checkRateLimit(rateLimit rateLimit) error {
+	if e.budget != nil {
+		e.budget.updateGraphQL(queryKey(e.lastQuery), rateLimit)
+	} else if rateLimit.ShouldPause() {
+		if err := e.control.Paused(rateLimit.ResetAt); err != nil {
+			return err
+		}
+		// pause until we are no longer rate limited
+		sdk.LogInfo(e.logger, "rate limited", "until", rateLimit.ResetAt)
+		sleep(time.Until(rateLimit.ResetAt))
+		sdk.LogInfo(e.logger, "rate limit wake up")
+		// send a resume now that we're no longer rate limited
+		if err := e.control.Resumed(); err != nil {
+			return err
+		}
+	}
+	sdk.LogDebug(e.logger, "rate limit detail", "remaining", rateLimit.Remaining, "cost", rateLimit.Cost, "total", rateLimit.Limit)
+	return nil
+}
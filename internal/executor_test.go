@@ -0,0 +1,184 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockGraphQLClient struct {
+	err   error
+	calls int
+}
+
+func (m *mockGraphQLClient) Query(query string, variables map[string]interface{}, out interface{}) error {
+	m.calls++
+	return m.err
+}
+func (m *mockGraphQLClient) Mutate(query string, variables map[string]interface{}, out interface{}) error {
+	m.calls++
+	return m.err
+}
+func (m *mockGraphQLClient) SetHeader(key string, value string) {}
+
+type mockControl struct{}
+
+func (m *mockControl) Paused(resetAt time.Time) error { return nil }
+func (m *mockControl) Resumed() error                 { return nil }
+
+// mockPagedGraphQLClient returns each of the responses in order and records the variables of each query
+type mockPagedGraphQLClient struct {
+	responses []string
+	variables []map[string]interface{}
+}
+
+func (m *mockPagedGraphQLClient) Query(query string, variables map[string]interface{}, out interface{}) error {
+	vars := make(map[string]interface{})
+	for k, v := range variables {
+		vars[k] = v
+	}
+	m.variables = append(m.variables, vars)
+	if len(m.responses) == 0 {
+		return errors.New("no more responses")
+	}
+	res := m.responses[0]
+	m.responses = m.responses[1:]
+	return json.Unmarshal([]byte(res), out)
+}
+func (m *mockPagedGraphQLClient) Mutate(query string, variables map[string]interface{}, out interface{}) error {
+	return m.Query(query, variables, out)
+}
+func (m *mockPagedGraphQLClient) SetHeader(key string, value string) {}
+
+type mockPipe struct {
+	sdk.Pipe
+	records []sdk.Model
+}
+
+func (p *mockPipe) Write(object sdk.Model) error {
+	p.records = append(p.records, object)
+	return nil
+}
+
+type mockState struct {
+	sdk.State
+	values map[string]string
+}
+
+func (s *mockState) Get(key string, out interface{}) (bool, error) {
+	val, ok := s.values[key]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal([]byte(val), out)
+}
+func (s *mockState) Set(key string, value interface{}) error {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if s.values == nil {
+		s.values = make(map[string]string)
+	}
+	s.values[key] = string(buf)
+	return nil
+}
+func (s *mockState) Exists(key string) bool {
+	_, ok := s.values[key]
+	return ok
+}
+func (s *mockState) SetWithExpires(key string, value interface{}, expiry time.Duration) error {
+	return s.Set(key, value)
+}
+
+type mockExport struct {
+	sdk.Export
+	state *mockState
+	pipe  *mockPipe
+}
+
+func (e *mockExport) Paused(resetAt time.Time) error { return nil }
+func (e *mockExport) Resumed() error                 { return nil }
+func (e *mockExport) CustomerID() string             { return "1234" }
+func (e *mockExport) IntegrationInstanceID() string  { return "1" }
+func (e *mockExport) State() sdk.State               { return e.state }
+func (e *mockExport) Pipe() sdk.Pipe                 { return e.pipe }
+
+func newMockUserManager(pipe sdk.Pipe) *UserManager {
+	return NewUserManager("1234", []string{"pinpt"}, &mockControl{}, &mockState{}, pipe, &GithubIntegration{}, "1", false)
+}
+
+func TestClassifyError(t *testing.T) {
+	assert := assert.New(t)
+	assert.Nil(classifyError(nil))
+	assert.Equal(queryErrorAbuse, classifyError(errors.New("You have triggered an abuse detection mechanism")).Type)
+	assert.Equal(queryErrorAbuse, classifyError(errors.New("You have exceeded a secondary rate limit")).Type)
+	assert.Equal(queryErrorRateLimit, classifyError(errors.New("API rate limit exceeded for user")).Type)
+	assert.Equal(queryErrorServer, classifyError(errors.New("Something went wrong while executing your query")).Type)
+	assert.Equal(queryErrorServer, classifyError(errors.New("unexpected EOF")).Type)
+	assert.Equal(queryErrorAuth, classifyError(errors.New("Bad credentials")).Type)
+	assert.Equal(queryErrorForbidden, classifyError(errors.New("Resource not accessible by integration")).Type)
+	assert.Equal(queryErrorNotFound, classifyError(errors.New("Could not resolve to a Repository with the name 'foo'")).Type)
+	assert.Equal(queryErrorSAML, classifyError(errors.New("Resource protected by organization SAML enforcement")).Type)
+	assert.Equal(queryErrorUnknown, classifyError(errors.New("boom")).Type)
+}
+
+func TestClassifyErrorWrapped(t *testing.T) {
+	assert := assert.New(t)
+	qerr := &queryError{Type: queryErrorAuth, Err: errors.New("nope")}
+	err := fmt.Errorf("error fetching repos: %w", qerr)
+	assert.Equal(qerr, classifyError(err))
+	assert.True(isQueryErrorType(err, queryErrorAuth))
+	assert.False(isQueryErrorType(err, queryErrorServer))
+	assert.False(qerr.Retryable())
+	assert.True((&queryError{Type: queryErrorServer}).Retryable())
+}
+
+func TestBackoff(t *testing.T) {
+	assert := assert.New(t)
+	for i := 1; i < 100; i++ {
+		d := backoff(i)
+		assert.True(d > 0)
+		assert.True(d <= backoffMax)
+	}
+	assert.True(backoff(1) <= backoffBase)
+}
+
+func TestShrinkPageSize(t *testing.T) {
+	assert := assert.New(t)
+	e := &queryExecutor{}
+	variables := map[string]interface{}{"first": 50}
+	e.shrinkPageSize(variables)
+	assert.Equal(25, variables["first"])
+	e.shrinkPageSize(variables)
+	assert.Equal(12, variables["first"])
+	e.shrinkPageSize(variables)
+	assert.Equal(defaultMinPageSize, variables["first"])
+	e.shrinkPageSize(variables)
+	assert.Equal(defaultMinPageSize, variables["first"])
+	variables = map[string]interface{}{}
+	e.shrinkPageSize(variables)
+	assert.Nil(variables["first"])
+}
+
+func TestQueryExecutorRetryBudget(t *testing.T) {
+	assert := assert.New(t)
+	client := &mockGraphQLClient{err: &queryError{Type: queryErrorServer, Err: errors.New("502 bad gateway")}}
+	e := &queryExecutor{client: client, control: &mockControl{}, maxRetries: 2}
+	sleep = func(d time.Duration) {}
+	defer func() { sleep = time.Sleep }()
+	err := e.Query("query", map[string]interface{}{"first": 40}, nil)
+	assert.Error(err)
+	assert.True(isQueryErrorType(err, queryErrorServer))
+	assert.Equal(3, client.calls)
+
+	client = &mockGraphQLClient{err: &queryError{Type: queryErrorNotFound, Err: errors.New("not found")}}
+	e.client = client
+	assert.Error(e.Query("query", nil, nil))
+	assert.Equal(1, client.calls)
+}
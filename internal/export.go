@@ -4,10 +4,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"net/url"
 	"strings"
-	"sync"
 	"time"
 
 	easyjson "github.com/mailru/easyjson"
@@ -16,8 +14,8 @@ import (
 
 const (
 	defaultPageSize                  = 50
-	defaultRetryPageSize             = 25
 	defaultPullRequestCommitPageSize = 100
+	defaultIssuePageSize             = 100
 	previousReposStateKey            = "previous_repos"
 	previousProjectsStateKey         = "previous_projects"
 	forceIncrementalStateKey         = "force_incremental"
@@ -25,59 +23,6 @@ const (
 
 type job func(export sdk.Export, pipe sdk.Pipe) error
 
-func (g *GithubIntegration) checkForRetryableError(logger sdk.Logger, control sdk.Control, err error) bool {
-	if strings.Contains(err.Error(), "Something went wrong while executing your query") || strings.Contains(err.Error(), "EOF") {
-		sdk.LogInfo(logger, "retryable error detected, will pause for about one minute", "err", err)
-		control.Paused(time.Now().Add(time.Minute))
-		time.Sleep(time.Minute + time.Millisecond*time.Duration(rand.Int63n(500)))
-		control.Resumed()
-		sdk.LogInfo(logger, "retryable error resumed")
-		return true
-	}
-	return false
-}
-
-func (g *GithubIntegration) checkForAbuseDetection(logger sdk.Logger, control sdk.Control, err error) bool {
-	// first check our retry-after since we get better resolution on how much to slow down
-	if ok, retry := sdk.IsRateLimitError(err); ok {
-		sdk.LogInfo(logger, "rate limit detected", "until", time.Now().Add(retry))
-		control.Paused(time.Now().Add(retry))
-		time.Sleep(retry)
-		control.Resumed()
-		sdk.LogInfo(logger, "rate limit wake up")
-		return true
-	}
-	if strings.Contains(err.Error(), "You have triggered an abuse detection mechanism") {
-		// we need to try and back off at least 1min + some randomized number of additional ms
-		sdk.LogInfo(logger, "abuse detection, will pause for about one minute")
-		control.Paused(time.Now().Add(time.Minute))
-		time.Sleep(time.Minute + time.Millisecond*time.Duration(rand.Int63n(500)))
-		control.Resumed()
-		sdk.LogInfo(logger, "abuse detection resumed")
-		return true
-	}
-	return false
-}
-
-func (g *GithubIntegration) checkForRateLimit(logger sdk.Logger, control sdk.Control, rateLimit rateLimit) error {
-	// check for rate limit
-	if rateLimit.ShouldPause() {
-		if err := control.Paused(rateLimit.ResetAt); err != nil {
-			return err
-		}
-		// pause until we are no longer rate limited
-		sdk.LogInfo(logger, "rate limited", "until", rateLimit.ResetAt)
-		time.Sleep(time.Until(rateLimit.ResetAt))
-		sdk.LogInfo(logger, "rate limit wake up")
-		// send a resume now that we're no longer rate limited
-		if err := control.Resumed(); err != nil {
-			return err
-		}
-	}
-	sdk.LogDebug(logger, "rate limit detail", "remaining", rateLimit.Remaining, "cost", rateLimit.Cost, "total", rateLimit.Limit)
-	return nil
-}
-
 func (g *GithubIntegration) fetchPullRequestCommits(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, customerID string, name string, pullRequestID string, repoID string, cursor string) ([]*sdk.SourceCodePullRequestCommit, error) {
 	sdk.LogInfo(logger, "need to run a pull request paginated commits starting from "+cursor, "repo", name, "pullrequest_id", pullRequestID)
 	after := cursor
@@ -85,32 +30,17 @@ func (g *GithubIntegration) fetchPullRequestCommits(logger sdk.Logger, client sd
 		"first": defaultPullRequestCommitPageSize,
 		"id":    pullRequestID,
 	}
-	var retryCount int
+	exec := g.newQueryExecutor(logger, client, control)
 	commits := make([]*sdk.SourceCodePullRequestCommit, 0)
 	for {
 		if after != "" {
 			variables["after"] = after
 		}
-		sdk.LogDebug(logger, "running queued pullrequests export", "repo", name, "after", after, "limit", variables["first"], "retryCount", retryCount)
+		sdk.LogDebug(logger, "running queued pullrequests export", "repo", name, "after", after, "limit", variables["first"])
 		var result pullrequestPagedCommitsResult
-		g.lock.Lock() // just to prevent too many GH requests
-		if err := client.Query(generateAllPRCommitsQuery("", after), variables, &result); err != nil {
-			g.lock.Unlock()
-			if g.checkForAbuseDetection(logger, control, err) {
-				continue
-			}
-			if g.checkForRetryableError(logger, control, err) {
-				retryCount++
-				variables["first"] = defaultRetryPageSize // back off the page size to see if this will help
-				if retryCount >= 10 {
-					return nil, fmt.Errorf("failed to export pull request commits after retrying 10 times for %s", name)
-				}
-				continue
-			}
-			return nil, err
+		if err := exec.Query(generateAllPRCommitsQuery("", after), variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching pull request commits for %s: %w", name, err)
 		}
-		g.lock.Unlock()
-		retryCount = 0
 		for _, edge := range result.Node.Commits.Edges {
 			prcommit, err := edge.Node.Commit.ToModel(logger, userManager, customerID, repoID, pullRequestID)
 			if err != nil {
@@ -118,7 +48,7 @@ func (g *GithubIntegration) fetchPullRequestCommits(logger sdk.Logger, client sd
 			}
 			commits = append(commits, prcommit)
 		}
-		if err := g.checkForRateLimit(logger, control, result.RateLimit); err != nil {
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
 			return nil, err
 		}
 		if !result.Node.PageInfo.HasNextPage {
@@ -129,7 +59,7 @@ func (g *GithubIntegration) fetchPullRequestCommits(logger sdk.Logger, client sd
 	return commits, nil
 }
 
-func (g *GithubIntegration) queuePullRequestJob(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, historical bool, repoName string, repoID string, beforeCursor string, afterCursor string) job {
+func (g *GithubIntegration) queuePullRequestJob(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, checkpoints *exportCheckpoints, errs *exportErrors, historical bool, repoName string, repoID string, beforeCursor string, afterCursor string) job {
 	repoOwner, repoLogin := g.getRepoDetails(repoName)
 	return func(export sdk.Export, pipe sdk.Pipe) error {
 		sdk.LogInfo(logger, "need to run a pull request job starting from "+afterCursor, "name", repoName, "owner", repoOwner)
@@ -144,37 +74,24 @@ func (g *GithubIntegration) queuePullRequestJob(logger sdk.Logger, client sdk.Gr
 			variables["before"] = beforeCursor
 		}
 		customerID := export.CustomerID()
-		var retryCount int
+		exec := g.newQueryExecutor(logger, client, export)
 		for {
-			sdk.LogDebug(logger, "running queued pullrequests export", "repo", repoName, "after", variables["after"], "limit", variables["first"], "retryCount", retryCount)
+			sdk.LogDebug(logger, "running queued pullrequests export", "repo", repoName, "after", variables["after"], "limit", variables["first"])
 			var result repositoryPullrequests
-			g.lock.Lock() // just to prevent too many GH requests
-			if err := client.Query(pullrequestPagedQuery, variables, &result); err != nil {
-				g.lock.Unlock()
-				if g.checkForAbuseDetection(logger, export, err) {
-					continue
-				}
-				if g.checkForRetryableError(logger, export, err) {
-					retryCount++
-					variables["first"] = defaultRetryPageSize // back off the page size to see if this will help
-					if retryCount >= 10 {
-						return fmt.Errorf("failed to export after retrying 10 times for %s", repoName)
-					}
-					continue
-				}
-				return err
+			if err := exec.Query(pullrequestPagedQuery, variables, &result); err != nil {
+				return fmt.Errorf("error fetching pull requests for %s: %w", repoName, err)
 			}
-			g.lock.Unlock()
-			retryCount = 0
 			for _, predge := range result.Repository.Pullrequests.Edges {
 				pullrequest, err := predge.Node.ToModel(logger, userManager, customerID, repoName, repoID)
 				if err != nil {
-					return fmt.Errorf("failed to convert pull request to model: %w", err)
+					errs.Add(repoName, "pull request", predge.Node.ID, fmt.Errorf("failed to convert pull request to model: %w", err))
+					continue
 				}
 				for _, reviewedge := range predge.Node.Reviews.Edges {
 					prreview, err := reviewedge.Node.ToModel(logger, userManager, customerID, repoID, pullrequest.ID)
 					if err != nil {
-						return err
+						errs.Add(repoName, "pull request review", reviewedge.Node.ID, err)
+						continue
 					}
 					if err := pipe.Write(prreview); err != nil {
 						return err
@@ -187,11 +104,44 @@ func (g *GithubIntegration) queuePullRequestJob(logger sdk.Logger, client sdk.Gr
 					}
 				}
 				for _, reviewreqedge := range predge.Node.ReviewRequests.Edges {
-					prreviewrequest, err := reviewreqedge.Node.ToModel(logger, userManager, customerID, repoID, pullrequest.ID, predge.Node.UpdatedAt)
+					prreviewrequests, err := reviewreqedge.Node.ToModel(logger, client, userManager, customerID, repoID, pullrequest.ID, predge.Node.UpdatedAt)
 					if err != nil {
+						if isFatalExportError(err) {
+							return err
+						}
+						errs.Add(repoName, "pull request review request", reviewreqedge.Node.ID, err)
+						continue
+					}
+					for _, prreviewrequest := range prreviewrequests {
+						if err := pipe.Write(prreviewrequest); err != nil {
+							return err
+						}
+					}
+				}
+				if predge.Node.ReviewRequests.PageInfo.HasNextPage {
+					job := g.queuePullRequestReviewRequestsJob(logger, client, userManager, repoName, repoID, pullrequest.ID, predge.Node.Number, predge.Node.UpdatedAt, predge.Node.ReviewRequests.PageInfo.EndCursor)
+					if err := job(export, pipe); err != nil {
 						return err
 					}
-					if err := pipe.Write(prreviewrequest); err != nil {
+				}
+				for _, thread := range predge.Node.ReviewThreads.Nodes {
+					comments, err := g.reviewThreadComments(logger, client, userManager, export, customerID, repoID, pullrequest.ID, thread)
+					if err != nil {
+						if isFatalExportError(err) {
+							return err
+						}
+						errs.Add(repoName, "pull request review thread", thread.ID, err)
+						continue
+					}
+					for _, comment := range comments {
+						if err := pipe.Write(comment); err != nil {
+							return err
+						}
+					}
+				}
+				if predge.Node.ReviewThreads.PageInfo.HasNextPage {
+					job := g.queuePullRequestReviewThreadsJob(logger, client, userManager, errs, repoName, repoID, pullrequest.ID, predge.Node.Number, predge.Node.ReviewThreads.PageInfo.EndCursor)
+					if err := job(export, pipe); err != nil {
 						return err
 					}
 				}
@@ -199,7 +149,8 @@ func (g *GithubIntegration) queuePullRequestJob(logger sdk.Logger, client sdk.Gr
 				for _, commitedge := range predge.Node.Commits.Edges {
 					prcommit, err := commitedge.Node.Commit.ToModel(logger, userManager, customerID, repoID, pullrequest.ID)
 					if err != nil {
-						return err
+						errs.Add(repoName, "pull request commit", commitedge.Node.Commit.Sha, err)
+						continue
 					}
 					commits = append(commits, prcommit)
 				}
@@ -207,18 +158,45 @@ func (g *GithubIntegration) queuePullRequestJob(logger sdk.Logger, client sdk.Gr
 					// fetch all the remaining paged commits
 					morecommits, err := g.fetchPullRequestCommits(logger, client, userManager, export, customerID, repoName, predge.Node.ID, pullrequest.RepoID, predge.Node.Commits.PageInfo.EndCursor)
 					if err != nil {
-						return err
+						if isFatalExportError(err) {
+							return err
+						}
+						// export the pull request with its first page of commits rather than dropping it
+						errs.Add(repoName, "pull request commits", predge.Node.ID, err)
+					} else {
+						commits = append(commits, morecommits...)
 					}
-					commits = append(commits, morecommits...)
 				}
 				// set the commits back on the pull request
 				setPullRequestCommits(pullrequest, commits)
+				if err := g.setPullRequestIssues(logger, client, export, pullrequest, repoName, predge.Node.ClosingIssues, pullRequestTexts(predge.Node.Title, predge.Node.RawBody, commits)...); err != nil {
+					return err
+				}
+				// export the pull request without its files rather than dropping it
+				if err := g.setPullRequestFiles(logger, client, export, pullrequest, repoName, predge.Node.Number, predge.Node.Files); err != nil {
+					if isFatalExportError(err) {
+						return err
+					}
+					errs.Add(repoName, "pull request files", predge.Node.ID, err)
+				}
+				// export the pull request without its events rather than dropping it
+				if err := g.setPullRequestEvents(logger, client, userManager, export, pullrequest, predge.Node.TimelineItems); err != nil {
+					if isFatalExportError(err) {
+						return err
+					}
+					errs.Add(repoName, "pull request timeline", predge.Node.ID, err)
+				}
 				// stream out all our commits
 				for _, commit := range commits {
 					if err := pipe.Write(commit); err != nil {
 						return err
 					}
 				}
+				for _, build := range predge.Node.HeadCommit.StatusBuilds(customerID, export.IntegrationInstanceID(), repoName, repoID, predge.Node.Branch) {
+					if err := pipe.Write(build); err != nil {
+						return err
+					}
+				}
 				// write the pull request after above in case we needed to get additional objects
 				if err := pipe.Write(pullrequest); err != nil {
 					return err
@@ -227,12 +205,16 @@ func (g *GithubIntegration) queuePullRequestJob(logger sdk.Logger, client sdk.Gr
 			if !result.Repository.Pullrequests.PageInfo.HasNextPage {
 				break
 			}
-			if err := g.checkForRateLimit(logger, export, result.RateLimit); err != nil {
+			cursor := result.Repository.Pullrequests.PageInfo.EndCursor
+			if err := checkpoints.Update(repoName, func(checkpoint *repoCheckpoint) { checkpoint.PullRequests.Cursor = cursor }); err != nil {
+				return err
+			}
+			if err := exec.checkRateLimit(result.RateLimit); err != nil {
 				return err
 			}
-			variables["after"] = result.Repository.Pullrequests.PageInfo.EndCursor
+			variables["after"] = cursor
 		}
-		return nil
+		return checkpoints.Update(repoName, func(checkpoint *repoCheckpoint) { checkpoint.PullRequests.Completed = true })
 	}
 }
 
@@ -248,9 +230,9 @@ func (g *GithubIntegration) queuePullRequestCommentsJob(logger sdk.Logger, clien
 			"number": prNumber,
 		}
 		customerID := export.CustomerID()
-		var retryCount int
+		exec := g.newQueryExecutor(logger, client, export)
 		for {
-			sdk.LogDebug(logger, "running queued pullrequests comments export", "number", prID, "repo", repoName, "after", variables["after"], "limit", variables["first"], "retryCount", retryCount)
+			sdk.LogDebug(logger, "running queued pullrequests comments export", "number", prID, "repo", repoName, "after", variables["after"], "limit", variables["first"])
 			var result struct {
 				RateLimit  rateLimit `json:"rateLimit"`
 				Repository struct {
@@ -259,24 +241,9 @@ func (g *GithubIntegration) queuePullRequestCommentsJob(logger sdk.Logger, clien
 					} `json:"pullRequest"`
 				} `json:"repository"`
 			}
-			g.lock.Lock() // just to prevent too many GH requests
-			if err := client.Query(pullrequestCommentsPagedQuery, variables, &result); err != nil {
-				g.lock.Unlock()
-				if g.checkForAbuseDetection(logger, export, err) {
-					continue
-				}
-				if g.checkForRetryableError(logger, export, err) {
-					retryCount++
-					variables["first"] = defaultRetryPageSize // back off the page size to see if this will help
-					if retryCount >= 10 {
-						return fmt.Errorf("failed to export after retrying 10 times for %s", repoName)
-					}
-					continue
-				}
-				return err
+			if err := exec.Query(pullrequestCommentsPagedQuery, variables, &result); err != nil {
+				return fmt.Errorf("error fetching pull request comments for %s: %w", repoName, err)
 			}
-			g.lock.Unlock()
-			retryCount = 0
 			for _, edge := range result.Repository.PullRequest.Comments.Edges {
 				prcomment, err := edge.Node.ToModel(logger, userManager, customerID, repoID, prID)
 				if err != nil {
@@ -289,7 +256,7 @@ func (g *GithubIntegration) queuePullRequestCommentsJob(logger sdk.Logger, clien
 			if !result.Repository.PullRequest.Comments.PageInfo.HasNextPage {
 				break
 			}
-			if err := g.checkForRateLimit(logger, export, result.RateLimit); err != nil {
+			if err := exec.checkRateLimit(result.RateLimit); err != nil {
 				return err
 			}
 			variables["after"] = result.Repository.PullRequest.Comments.PageInfo.EndCursor
@@ -298,6 +265,104 @@ func (g *GithubIntegration) queuePullRequestCommentsJob(logger sdk.Logger, clien
 	}
 }
 
+func (g *GithubIntegration) queuePullRequestReviewRequestsJob(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, repoName string, repoID string, prID string, prNumber int, prUpdatedAt time.Time, cursor string) job {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	return func(export sdk.Export, pipe sdk.Pipe) error {
+		sdk.LogInfo(logger, "need to run a pull request review requests job starting from "+cursor, "name", repoName, "owner", repoOwner)
+		var variables = map[string]interface{}{
+			"first":  defaultPageSize,
+			"after":  cursor,
+			"owner":  repoOwner,
+			"name":   repoLogin,
+			"number": prNumber,
+		}
+		customerID := export.CustomerID()
+		exec := g.newQueryExecutor(logger, client, export)
+		for {
+			sdk.LogDebug(logger, "running queued pullrequests review requests export", "number", prID, "repo", repoName, "after", variables["after"], "limit", variables["first"])
+			var result struct {
+				RateLimit  rateLimit `json:"rateLimit"`
+				Repository struct {
+					PullRequest struct {
+						ReviewRequests pullrequestreviewrequests `json:"reviewRequests"`
+					} `json:"pullRequest"`
+				} `json:"repository"`
+			}
+			if err := exec.Query(pullrequestReviewRequestsPagedQuery, variables, &result); err != nil {
+				return fmt.Errorf("error fetching pull request review requests for %s: %w", repoName, err)
+			}
+			for _, edge := range result.Repository.PullRequest.ReviewRequests.Edges {
+				prreviewrequests, err := edge.Node.ToModel(logger, client, userManager, customerID, repoID, prID, prUpdatedAt)
+				if err != nil {
+					return err
+				}
+				for _, prreviewrequest := range prreviewrequests {
+					if err := pipe.Write(prreviewrequest); err != nil {
+						return err
+					}
+				}
+			}
+			if !result.Repository.PullRequest.ReviewRequests.PageInfo.HasNextPage {
+				break
+			}
+			if err := exec.checkRateLimit(result.RateLimit); err != nil {
+				return err
+			}
+			variables["after"] = result.Repository.PullRequest.ReviewRequests.PageInfo.EndCursor
+		}
+		return nil
+	}
+}
+
+func (g *GithubIntegration) queueIssueCommentsJob(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, repoName string, projectID string, issueID string, issueNumber int, cursor string) job {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	return func(export sdk.Export, pipe sdk.Pipe) error {
+		sdk.LogInfo(logger, "need to run an issue comments job starting before "+cursor, "name", repoName, "owner", repoOwner)
+		var variables = map[string]interface{}{
+			"first":  defaultPageSize,
+			"before": cursor,
+			"owner":  repoOwner,
+			"name":   repoLogin,
+			"number": issueNumber,
+		}
+		customerID := export.CustomerID()
+		integrationInstanceID := export.IntegrationInstanceID()
+		exec := g.newQueryExecutor(logger, client, export)
+		for {
+			sdk.LogDebug(logger, "running queued issue comments export", "number", issueNumber, "repo", repoName, "before", variables["before"], "limit", variables["first"])
+			var result struct {
+				RateLimit  rateLimit `json:"rateLimit"`
+				Repository struct {
+					Issue struct {
+						Comments commentsNode `json:"comments"`
+					} `json:"issue"`
+				} `json:"repository"`
+			}
+			if err := exec.Query(issueCommentsPagedQuery, variables, &result); err != nil {
+				return fmt.Errorf("error fetching issue comments for %s: %w", repoName, err)
+			}
+			for _, c := range result.Repository.Issue.Comments.Nodes {
+				comment, err := c.ToModel(logger, userManager, customerID, integrationInstanceID, projectID, issueID)
+				if err != nil {
+					return err
+				}
+				if err := pipe.Write(comment); err != nil {
+					return err
+				}
+			}
+			// we page backwards since the issue query fetches the most recent comments
+			if !result.Repository.Issue.Comments.PageInfo.HasPreviousPage {
+				break
+			}
+			if err := exec.checkRateLimit(result.RateLimit); err != nil {
+				return err
+			}
+			variables["before"] = result.Repository.Issue.Comments.PageInfo.StartCursor
+		}
+		return nil
+	}
+}
+
 func (g *GithubIntegration) queuePullRequestReviewsJob(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, repoName string, repoID string, prID string, prNumber int, cursor string) job {
 	repoOwner, repoLogin := g.getRepoDetails(repoName)
 	return func(export sdk.Export, pipe sdk.Pipe) error {
@@ -312,9 +377,9 @@ func (g *GithubIntegration) queuePullRequestReviewsJob(logger sdk.Logger, client
 			variables["after"] = cursor
 		}
 		customerID := export.CustomerID()
-		var retryCount int
+		exec := g.newQueryExecutor(logger, client, export)
 		for {
-			sdk.LogDebug(logger, "running queued pullrequests reviews export", "number", prID, "repo", repoName, "after", variables["after"], "limit", variables["first"], "retryCount", retryCount)
+			sdk.LogDebug(logger, "running queued pullrequests reviews export", "number", prID, "repo", repoName, "after", variables["after"], "limit", variables["first"])
 			var result struct {
 				RateLimit  rateLimit `json:"rateLimit"`
 				Repository struct {
@@ -323,24 +388,9 @@ func (g *GithubIntegration) queuePullRequestReviewsJob(logger sdk.Logger, client
 					} `json:"pullRequest"`
 				} `json:"repository"`
 			}
-			g.lock.Lock() // just to prevent too many GH requests
-			if err := client.Query(pullrequestReviewsPagedQuery, variables, &result); err != nil {
-				g.lock.Unlock()
-				if g.checkForAbuseDetection(logger, export, err) {
-					continue
-				}
-				if g.checkForRetryableError(logger, export, err) {
-					retryCount++
-					variables["first"] = defaultRetryPageSize // back off the page size to see if this will help
-					if retryCount >= 10 {
-						return fmt.Errorf("failed to export after retrying 10 times for %s", repoName)
-					}
-					continue
-				}
+			if err := exec.Query(pullrequestReviewsPagedQuery, variables, &result); err != nil {
 				return fmt.Errorf("error fetching pull request reviews: %w", err)
 			}
-			g.lock.Unlock()
-			retryCount = 0
 			for _, edge := range result.Repository.PullRequest.Reviews.Edges {
 				prreview, err := edge.Node.ToModel(logger, userManager, customerID, repoID, prID)
 				if err != nil {
@@ -353,7 +403,7 @@ func (g *GithubIntegration) queuePullRequestReviewsJob(logger sdk.Logger, client
 			if !result.Repository.PullRequest.Reviews.PageInfo.HasNextPage {
 				break
 			}
-			if err := g.checkForRateLimit(logger, export, result.RateLimit); err != nil {
+			if err := exec.checkRateLimit(result.RateLimit); err != nil {
 				return err
 			}
 			variables["after"] = result.Repository.PullRequest.Reviews.PageInfo.EndCursor
@@ -369,33 +419,21 @@ func (g *GithubIntegration) fetchAllRepos(logger sdk.Logger, client sdk.GraphQLC
 		"login": login,
 	}
 	var after string
-	var retryCount int
+	exec := g.newQueryExecutor(logger, client, export)
 	for {
 		if after != "" {
 			variables["after"] = after
 		}
-		sdk.LogDebug(logger, "running fetch all repos", "login", login, "after", after, "limit", variables["first"], "retryCount", retryCount)
+		sdk.LogDebug(logger, "running fetch all repos", "login", login, "after", after, "limit", variables["first"])
 		var result repoWithNameResult
-		if err := client.Query(generateAllReposQuery(after, scope), variables, &result); err != nil {
-			if g.checkForAbuseDetection(logger, export, err) {
-				continue
-			}
-			if g.checkForRetryableError(logger, export, err) {
-				retryCount++
-				variables["first"] = defaultRetryPageSize // back off the page size to see if this will help
-				if retryCount >= 10 {
-					return nil, fmt.Errorf("failed to fetch repos after retrying 10 times for %s (%s)", login, scope)
-				}
-				continue
-			}
-			return nil, err
+		if err := exec.Query(generateAllReposQuery(after, scope), variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching repos for %s (%s): %w", login, scope, err)
 		}
-		retryCount = 0
 		for _, repo := range result.Data.Repositories.Nodes {
 			sdk.LogDebug(logger, "found a repo", "name", repo.Name)
 			repos = append(repos, repo)
 		}
-		if err := g.checkForRateLimit(logger, export, result.RateLimit); err != nil {
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
 			return nil, err
 		}
 		if !result.Data.Repositories.PageInfo.HasNextPage {
@@ -408,87 +446,72 @@ func (g *GithubIntegration) fetchAllRepos(logger sdk.Logger, client sdk.GraphQLC
 }
 
 func (g *GithubIntegration) fetchViewer(logger sdk.Logger, client sdk.GraphQLClient, export sdk.Control) (*viewer, error) {
-	var retryCount int
-	for {
-		sdk.LogDebug(logger, "running viewer query", "retryCount", retryCount)
-		var result viewerResult
-		if err := client.Query(viewerQuery, nil, &result); err != nil {
-			if g.checkForAbuseDetection(logger, export, err) {
-				continue
-			}
-			if g.checkForRetryableError(logger, export, err) {
-				retryCount++
-				continue
-			}
-			return nil, err
-		}
-		retryCount = 0
-		return &result.Viewer, nil
+	sdk.LogDebug(logger, "running viewer query")
+	var result viewerResult
+	if err := g.newQueryExecutor(logger, client, export).Query(viewerQuery, nil, &result); err != nil {
+		return nil, err
 	}
+	return &result.Viewer, nil
 }
 
 // fetchOrgs will fetch all orgs this user is a member of
 func (g *GithubIntegration) fetchOrgs(logger sdk.Logger, client sdk.GraphQLClient, export sdk.Control) ([]*org, error) {
 	var allorgs allOrgsResult
 	var orgs []*org
-	for {
-		if err := client.Query(allOrgsQuery, map[string]interface{}{"first": 100}, &allorgs); err != nil {
-			if g.checkForAbuseDetection(logger, export, err) {
-				continue
-			}
-			return nil, err
-		}
-		for _, node := range allorgs.Viewer.Organizations.Nodes {
-			if node.IsMember {
-				orgs = append(orgs, node)
-			} else {
-				sdk.LogInfo(logger, "skipping "+node.Login+" the authorized user is not a member of this org")
-			}
+	if err := g.newQueryExecutor(logger, client, export).Query(allOrgsQuery, map[string]interface{}{"first": 100}, &allorgs); err != nil {
+		return nil, err
+	}
+	for _, node := range allorgs.Viewer.Organizations.Nodes {
+		if node.IsMember {
+			orgs = append(orgs, node)
+		} else {
+			sdk.LogInfo(logger, "skipping "+node.Login+" the authorized user is not a member of this org")
 		}
-		break
 	}
 	return orgs, nil
 }
 
-func (g *GithubIntegration) fetchAllRepoMilestones(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, export sdk.Export, repoName, repoRefID string, historical bool) error {
+func (g *GithubIntegration) fetchAllRepoMilestones(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, checkpoints *exportCheckpoints, errs *exportErrors, export sdk.Export, repoName, repoRefID string, historical bool) error {
 	repoOwner, repoLogin := g.getRepoDetails(repoName)
 	var variables = map[string]interface{}{
 		"owner": repoOwner,
 		"name":  repoLogin,
+		"first": defaultIssuePageSize,
 	}
 	var after, before string
-	var retryCount int
 	var first string
 	customerID := export.CustomerID()
 	integrationInstanceID := export.IntegrationInstanceID()
 	projectID := sdk.NewWorkProjectID(customerID, repoRefID, refType)
 	pipe := export.Pipe()
 	state := export.State()
+	exec := g.newQueryExecutor(logger, client, export)
 	state.Get("milestones_"+repoName, &before)
 	if before != "" {
 		variables["before"] = before
 	}
+	checkpoint, err := checkpoints.Get(repoName)
+	if err != nil {
+		return err
+	}
+	// resume where a previous export stopped
+	after = checkpoint.Milestones.Cursor
+	first = checkpoint.Milestones.First
 	for {
 		if after != "" {
 			variables["after"] = after
 			delete(variables, "before")
 		}
-		sdk.LogDebug(logger, "running fetch all repo milestones", "name", repoName, "login", repoLogin, "after", after, "limit", variables["first"], "retryCount", retryCount)
+		sdk.LogDebug(logger, "running fetch all repo milestones", "name", repoName, "login", repoLogin, "after", after)
 		var result repositoryMilestonesResult
-		if err := client.Query(repositoryMilestonesQuery, variables, &result); err != nil {
-			if g.checkForAbuseDetection(logger, export, err) {
-				continue
-			}
-			if g.checkForRetryableError(logger, export, err) {
-				continue
-			}
+		if err := exec.Query(repositoryMilestonesQuery, variables, &result); err != nil {
 			return err
 		}
-		retryCount = 0
 		for _, node := range result.Repository.Milestones.Nodes {
 			issue, err := node.ToModel(logger, userManager, customerID, integrationInstanceID, repoName, projectID)
 			if err != nil {
-				return err
+				errs.Add(repoName, "milestone", node.ID, err)
+				continue
 			}
 			if issue != nil {
 				if err := pipe.Write(issue); err != nil {
@@ -496,7 +519,7 @@ func (g *GithubIntegration) fetchAllRepoMilestones(logger sdk.Logger, client sdk
 				}
 			}
 		}
-		if err := g.checkForRateLimit(logger, export, result.RateLimit); err != nil {
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
 			return err
 		}
 		if first == "" {
@@ -506,11 +529,96 @@ func (g *GithubIntegration) fetchAllRepoMilestones(logger sdk.Logger, client sdk
 			break
 		}
 		after = result.Repository.Milestones.PageInfo.EndCursor
+		if err := checkpoints.Update(repoName, func(checkpoint *repoCheckpoint) {
+			checkpoint.Milestones.Cursor = after
+			checkpoint.Milestones.First = first
+		}); err != nil {
+			return err
+		}
 	}
 	if first != "" {
-		return state.Set("milestones_"+repoName, first)
+		if err := state.Set("milestones_"+repoName, first); err != nil {
+			return err
+		}
 	}
-	return nil
+	return checkpoints.Update(repoName, func(checkpoint *repoCheckpoint) { checkpoint.Milestones.Completed = true })
+}
+
+// fetchDefaultBranchCommits will export the commit history of the default branch, in an incremental only
+// the commits since the previous export are exported
+func (g *GithubIntegration) fetchDefaultBranchCommits(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, checkpoints *exportCheckpoints, errs *exportErrors, export sdk.Export, repoName, repoID string, historical bool) error {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	var variables = map[string]interface{}{
+		"first": defaultPageSize,
+		"owner": repoOwner,
+		"name":  repoLogin,
+	}
+	customerID := export.CustomerID()
+	pipe := export.Pipe()
+	state := export.State()
+	exec := g.newQueryExecutor(logger, client, export)
+	if !historical {
+		var since string
+		state.Get(g.getCommitsKey(repoName), &since)
+		if since != "" {
+			variables["since"] = since
+		}
+	}
+	checkpoint, err := checkpoints.Get(repoName)
+	if err != nil {
+		return err
+	}
+	// resume where a previous export stopped, keeping the time that export started
+	after := checkpoint.Commits.Cursor
+	started := checkpoint.Commits.First
+	if started == "" {
+		started = time.Now().UTC().Format(time.RFC3339)
+	}
+	var count int
+	for {
+		if after != "" {
+			variables["after"] = after
+		}
+		sdk.LogDebug(logger, "running fetch default branch commits", "name", repoName, "after", after, "since", variables["since"])
+		var result defaultBranchCommitsResult
+		if err := exec.Query(defaultBranchCommitsQuery, variables, &result); err != nil {
+			return err
+		}
+		if result.Repository.DefaultBranchRef == nil {
+			// an empty repo has no default branch
+			break
+		}
+		history := result.Repository.DefaultBranchRef.Target.History
+		for _, node := range history.Nodes {
+			commit, err := node.ToModel(logger, userManager, customerID, repoName, repoID)
+			if err != nil {
+				errs.Add(repoName, "commit", node.Sha, err)
+				continue
+			}
+			if err := pipe.Write(commit); err != nil {
+				return err
+			}
+			count++
+		}
+		if !history.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return err
+		}
+		after = history.PageInfo.EndCursor
+		if err := checkpoints.Update(repoName, func(checkpoint *repoCheckpoint) {
+			checkpoint.Commits.Cursor = after
+			checkpoint.Commits.First = started
+		}); err != nil {
+			return err
+		}
+	}
+	sdk.LogDebug(logger, "fetched default branch commits", "name", repoName, "count", count)
+	if err := state.Set(g.getCommitsKey(repoName), started); err != nil {
+		return err
+	}
+	return checkpoints.Update(repoName, func(checkpoint *repoCheckpoint) { checkpoint.Commits.Completed = true })
 }
 
 func (g *GithubIntegration) getRepoDetails(repoName string) (string, string) {
@@ -518,62 +626,89 @@ func (g *GithubIntegration) getRepoDetails(repoName string) (string, string) {
 	return tok[0], tok[1]
 }
 
-func (g *GithubIntegration) fetchAllRepoIssues(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, export sdk.Export, repoName, repoRefID string, historical bool) error {
+func (g *GithubIntegration) fetchAllRepoIssues(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, issueTypes issueTypeMapping, priorities issuePriorityMapping, checkpoints *exportCheckpoints, errs *exportErrors, export sdk.Export, repoName, repoRefID string, historical bool, queue func(j checkpointJob) error) error {
 	repoOwner, repoLogin := g.getRepoDetails(repoName)
 	var variables = map[string]interface{}{
 		"owner": repoOwner,
 		"name":  repoLogin,
+		"first": defaultIssuePageSize,
 	}
 	var after, before string
-	var retryCount int
 	var first string
 	customerID := export.CustomerID()
 	integrationInstanceID := export.IntegrationInstanceID()
 	projectID := sdk.NewWorkProjectID(customerID, repoRefID, refType)
 	pipe := export.Pipe()
 	state := export.State()
+	exec := g.newQueryExecutor(logger, client, export)
 	state.Get("issues_"+repoName, &before)
 	if before != "" {
 		variables["before"] = before
 	}
+	checkpoint, err := checkpoints.Get(repoName)
+	if err != nil {
+		return err
+	}
+	// resume where a previous export stopped
+	after = checkpoint.Issues.Cursor
+	first = checkpoint.Issues.First
 	for {
 		if after != "" {
 			variables["after"] = after
 			delete(variables, "before")
 		}
-		sdk.LogDebug(logger, "running fetch all repo issues", "name", repoName, "login", repoLogin, "after", after, "limit", variables["first"], "retryCount", retryCount)
+		sdk.LogDebug(logger, "running fetch all repo issues", "name", repoName, "login", repoLogin, "after", after)
 		var result issueResult
-		if err := client.Query(issuesQuery, variables, &result); err != nil {
-			if g.checkForAbuseDetection(logger, export, err) {
-				continue
-			}
-			if g.checkForRetryableError(logger, export, err) {
-				continue
-			}
+		if err := exec.Query(issuesQuery, variables, &result); err != nil {
 			return err
 		}
-		retryCount = 0
 		for _, node := range result.Repository.Issues.Nodes {
-			issue, err := node.ToModel(logger, userManager, customerID, integrationInstanceID, repoName, projectID)
+			if node.Labels.PageInfo.HasNextPage {
+				more, err := g.fetchIssueLabels(logger, client, export, node.ID, node.Labels.PageInfo.EndCursor)
+				if err != nil {
+					if isFatalExportError(err) {
+						return err
+					}
+					// keep going with the labels we have
+					errs.Add(repoName, "issue labels", node.ID, err)
+				}
+				node.Labels.Nodes = append(node.Labels.Nodes, more...)
+			}
+			issue, err := node.ToModel(logger, userManager, issueTypes, priorities, customerID, integrationInstanceID, repoName, projectID)
 			if err != nil {
-				return err
+				errs.Add(repoName, "issue", node.ID, err)
+				continue
 			}
 			if issue != nil {
+				// export the issue without its changelog rather than dropping it
+				if err := g.setIssueChangeLog(logger, client, userManager, export, issue, node.Timeline); err != nil {
+					if isFatalExportError(err) {
+						return err
+					}
+					errs.Add(repoName, "issue changelog", node.ID, err)
+				}
 				if err := pipe.Write(issue); err != nil {
 					return err
 				}
 				for _, c := range node.Comments.Nodes {
 					comment, err := c.ToModel(logger, userManager, customerID, integrationInstanceID, projectID, issue.ID)
 					if err != nil {
-						return err
+						errs.Add(repoName, "issue comment", c.ID, err)
+						continue
 					}
 					if err := pipe.Write(comment); err != nil {
 						return err
 					}
 				}
+				if node.Comments.PageInfo.HasPreviousPage {
+					// the issue query only fetches the most recent comments, fetch the older ones in a job
+					if err := queue(checkpointJob{Type: checkpointJobIssueComments, ID: issue.ID, Number: node.Number, Cursor: node.Comments.PageInfo.StartCursor}); err != nil {
+						return err
+					}
+				}
 			}
 		}
-		if err := g.checkForRateLimit(logger, export, result.RateLimit); err != nil {
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
 			return err
 		}
 		if first == "" {
@@ -583,106 +718,106 @@ func (g *GithubIntegration) fetchAllRepoIssues(logger sdk.Logger, client sdk.Gra
 			break
 		}
 		after = result.Repository.Issues.PageInfo.EndCursor
+		if err := checkpoints.Update(repoName, func(checkpoint *repoCheckpoint) {
+			checkpoint.Issues.Cursor = after
+			checkpoint.Issues.First = first
+		}); err != nil {
+			return err
+		}
 	}
 	if first != "" {
-		return state.Set("issues_"+repoName, first)
+		if err := state.Set("issues_"+repoName, first); err != nil {
+			return err
+		}
 	}
-	return nil
+	return checkpoints.Update(repoName, func(checkpoint *repoCheckpoint) { checkpoint.Issues.Completed = true })
 }
 
 func (g *GithubIntegration) fetchRepoProject(logger sdk.Logger, client sdk.GraphQLClient, pipe sdk.Pipe, control sdk.Control, customerID, integrationInstanceID, repoName, repoRefID string, num int) error {
 	repoOwner, repoLogin := g.getRepoDetails(repoName)
-	var retryCount int
 	variables := map[string]interface{}{
 		"owner": repoOwner,
 		"name":  repoLogin,
 		"num":   num,
 	}
-	for {
-		sdk.LogDebug(logger, "running repo project query", "retryCount", retryCount, "num", num, "name", repoName)
-		var result repoProjectResult
-		if err := client.Query(repoProjectQuery, variables, &result); err != nil {
-			if g.checkForAbuseDetection(logger, control, err) {
-				continue
-			}
-			if g.checkForRetryableError(logger, control, err) {
-				retryCount++
-				continue
-			}
+	sdk.LogDebug(logger, "running repo project query", "num", num, "name", repoName)
+	var result repoProjectResult
+	if err := g.newQueryExecutor(logger, client, control).Query(repoProjectQuery, variables, &result); err != nil {
+		return err
+	}
+	projectID := sdk.NewWorkProjectID(customerID, repoRefID, refType)
+	b, p := result.Repository.Project.ToModel(logger, customerID, integrationInstanceID, projectID)
+	if b != nil {
+		sdk.LogDebug(logger, "writing repo board", "name", repoName)
+		if err := pipe.Write(b); err != nil {
 			return err
 		}
-		projectID := sdk.NewWorkProjectID(customerID, repoRefID, refType)
-		b, p := result.Repository.Project.ToModel(logger, customerID, integrationInstanceID, projectID)
-		if b != nil {
-			sdk.LogDebug(logger, "writing repo board", "name", repoName)
-			if err := pipe.Write(b); err != nil {
-				return err
-			}
-		}
-		if p != nil {
-			sdk.LogDebug(logger, "writing repo project", "name", repoName)
-			if err := pipe.Write(p); err != nil {
-				return err
-			}
+	}
+	if p != nil {
+		sdk.LogDebug(logger, "writing repo project", "name", repoName)
+		if err := pipe.Write(p); err != nil {
+			return err
 		}
-		retryCount = 0
-		return nil
 	}
+	return nil
 }
 
 func (g *GithubIntegration) fetchRepoProjects(logger sdk.Logger, client sdk.GraphQLClient, export sdk.Export, repoName, repoRefID string) error {
 	repoOwner, repoLogin := g.getRepoDetails(repoName)
-	var retryCount int
 	variables := map[string]interface{}{
 		"owner": repoOwner,
 		"name":  repoLogin,
 	}
-	for {
-		sdk.LogDebug(logger, "running repo project query", "retryCount", retryCount, "name", repoName)
-		var result repoProjectsResult
-		if err := client.Query(repoProjectsQuery, variables, &result); err != nil {
-			if g.checkForAbuseDetection(logger, export, err) {
-				continue
-			}
-			if g.checkForRetryableError(logger, export, err) {
-				retryCount++
-				continue
+	sdk.LogDebug(logger, "running repo project query", "name", repoName)
+	var result repoProjectsResult
+	if err := g.newQueryExecutor(logger, client, export).Query(repoProjectsQuery, variables, &result); err != nil {
+		return err
+	}
+	for _, project := range result.Repository.Projects.Nodes {
+		projectID := sdk.NewWorkProjectID(export.CustomerID(), repoRefID, refType)
+		b, p := project.ToModel(logger, export.CustomerID(), export.IntegrationInstanceID(), projectID)
+		if b != nil {
+			sdk.LogDebug(logger, "writing repo board", "name", project.Name)
+			if err := export.Pipe().Write(b); err != nil {
+				return err
 			}
-			return err
 		}
-		for _, project := range result.Repository.Projects.Nodes {
-			projectID := sdk.NewWorkProjectID(export.CustomerID(), repoRefID, refType)
-			b, p := project.ToModel(logger, export.CustomerID(), export.IntegrationInstanceID(), projectID)
-			if b != nil {
-				sdk.LogDebug(logger, "writing repo board", "name", project.Name)
-				if err := export.Pipe().Write(b); err != nil {
-					return err
-				}
-			}
-			if p != nil {
-				sdk.LogDebug(logger, "writing repo project", "name", project.Name)
-				if err := export.Pipe().Write(p); err != nil {
-					return err
-				}
+		if p != nil {
+			sdk.LogDebug(logger, "writing repo project", "name", project.Name)
+			if err := export.Pipe().Write(p); err != nil {
+				return err
 			}
 		}
-		retryCount = 0
-		return nil
 	}
+	return nil
 }
 
 func (g *GithubIntegration) getRepoKey(name string) string {
 	return fmt.Sprintf("repo_cursor_%s", name)
 }
 
+func (g *GithubIntegration) getCommitsKey(name string) string {
+	return fmt.Sprintf("commits_%s", name)
+}
+
+// resetRepoState will remove the incremental cursors for a repo so that the next export will export it in full
+func (g *GithubIntegration) resetRepoState(state sdk.State, name string) error {
+	for _, key := range []string{g.getRepoKey(name), g.getCommitsKey(name), g.getCommitCommentsKey(name), g.getReleasesKey(name), g.getDeploymentsKey(name), g.getDeploymentShasKey(name), g.getBuildsKey(name), g.getSecurityAlertsKey(name), "issues_" + name, "milestones_" + name} {
+		if err := state.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (g *GithubIntegration) fetchRepos(logger sdk.Logger, client sdk.GraphQLClient, export sdk.Export, repos []string) ([]repository, error) {
 	results := make([]repository, 0)
-	var retryCount int
 	var offset int
 	const max = 5
 	state := export.State()
+	exec := g.newQueryExecutor(logger, client, export)
 	for offset < len(repos) {
-		sdk.LogDebug(logger, "running repo query", "retryCount", retryCount, "offset", offset, "length", len(repos))
+		sdk.LogDebug(logger, "running repo query", "offset", offset, "length", len(repos))
 		result := make(map[string]json.RawMessage)
 		var sb strings.Builder
 		end := offset + max
@@ -701,14 +836,7 @@ func (g *GithubIntegration) fetchRepos(logger sdk.Logger, client sdk.GraphQLClie
 			}
 			sb.WriteString(getAllRepoDataQuery(owner, name, label, cursor))
 		}
-		if err := client.Query("query { "+sb.String()+" rateLimit { limit cost remaining resetAt } }", nil, &result); err != nil {
-			if g.checkForAbuseDetection(logger, export, err) {
-				continue
-			}
-			if g.checkForRetryableError(logger, export, err) {
-				retryCount++
-				continue
-			}
+		if err := exec.Query("query { "+sb.String()+" rateLimit { limit cost remaining resetAt } }", nil, &result); err != nil {
 			return nil, err
 		}
 		for key, buf := range result {
@@ -717,7 +845,7 @@ func (g *GithubIntegration) fetchRepos(logger sdk.Logger, client sdk.GraphQLClie
 				if err := easyjson.Unmarshal(buf, &rl); err != nil {
 					return nil, err
 				}
-				if err := g.checkForRateLimit(logger, export, rl); err != nil {
+				if err := exec.checkRateLimit(rl); err != nil {
 					return nil, err
 				}
 			} else {
@@ -729,7 +857,6 @@ func (g *GithubIntegration) fetchRepos(logger sdk.Logger, client sdk.GraphQLClie
 				offset++
 			}
 		}
-		retryCount = 0
 	}
 	sdk.LogInfo(logger, "returning from fetchRepos", "len", len(results))
 	return results, nil
@@ -880,6 +1007,20 @@ func (g *GithubIntegration) Export(export sdk.Export) error {
 		return fmt.Errorf("error creating http client: %w", err)
 	}
 
+	issueTypes, err := g.getIssueTypeMapping(config)
+	if err != nil {
+		return err
+	}
+	priorities, err := g.getIssuePriorityMapping(config)
+	if err != nil {
+		return err
+	}
+
+	// all the requests for this instance share the same rate limits
+	budget := g.getRateLimitBudget(export.IntegrationInstanceID())
+	client = budget.graphQLClient(logger, export, client)
+	httpclient = budget.httpClient(logger, export, httpclient)
+
 	sdk.LogInfo(logger, "export starting", "url", url)
 
 	// TODO: add skip public repos since we're going to have a specific customer_id (empty) to do those in the future
@@ -980,9 +1121,9 @@ func (g *GithubIntegration) Export(export sdk.Export) error {
 	instanceID := export.IntegrationInstanceID()
 	state := export.State()
 	userManager := NewUserManager(customerID, orgs, export, state, pipe, g, instanceID, export.Historical())
-	jobs := make([]job, 0)
+	userManager.expandTeams = g.getExpandTeamReviewRequests(config)
+	checkpoints := newExportCheckpoints(state, export.Historical())
 	started := time.Now()
-	var repoCount, prCount, reviewCount, reviewRequestCount, commitCount, commentCount int
 	var hasPreviousRepos bool
 	previousRepos := make(map[string]*sdk.SourceCodeRepo)
 	previousProjects := make(map[string]*sdk.WorkProject)
@@ -1000,6 +1141,16 @@ func (g *GithubIntegration) Export(export sdk.Export) error {
 		}
 	}
 
+	// any repos which failed in the previous export need to be exported again
+	retryRepos := make(map[string]bool)
+	if state.Exists(failedReposStateKey) {
+		if _, err := state.Get(failedReposStateKey, &retryRepos); err != nil {
+			sdk.LogError(logger, "error fetching failed repos state", "err", err)
+		} else if len(retryRepos) > 0 {
+			sdk.LogInfo(logger, "retrying repos which failed in the previous export", "count", len(retryRepos))
+		}
+	}
+
 	// if the state key doesnt exist then it's time to run an incremental
 	forceIncremental := !state.Exists(forceIncrementalStateKey)
 	if forceIncremental {
@@ -1054,171 +1205,74 @@ func (g *GithubIntegration) Export(export sdk.Export) error {
 		return fmt.Errorf("error processing default issue type: %w", err)
 	}
 
-	for _, node := range therepos {
-		sdk.LogInfo(logger, "processing repo: "+node.Name, "id", node.ID)
-
-		repoCount++
-		r := repos[node.Name]
-
-		hookInstalled, err := g.installRepoWebhookIfRequired(g.manager.WebHookManager(), logger, httpclient, customerID, instanceID, r.Login, r.Name, r.ID)
-		if err != nil {
-			return err
-		}
+	if err := g.processIssuePriorities(logger, pipe, state, customerID, instanceID, export.Historical(), priorities); err != nil {
+		return fmt.Errorf("error processing issue priorities: %w", err)
+	}
 
-		repo, project, capability := node.ToModel(export.State(), export.Historical(), customerID, instanceID, r.Login, r.IsPrivate, r.Scope)
+	if err := g.processIssueResolutions(logger, pipe, state, customerID, instanceID, export.Historical()); err != nil {
+		return fmt.Errorf("error processing issue resolutions: %w", err)
+	}
 
-		previousRepos[node.Name] = repo // remember it
-		if project != nil {
-			previousProjects[repo.ID] = project
-		}
+	// export the most recently updated repos first, in parallel. the rate limit budget will limit how many
+	// requests are running at the same time so we don't trigger GitHub's abuse detection
+	sortReposByUpdated(therepos)
+	concurrency := g.getExportConcurrency(config)
+	g.getRateLimitBudget(instanceID).setConcurrency(concurrency)
+	sdk.LogInfo(logger, "exporting repos", "count", len(therepos), "concurrency", concurrency)
+	securityEvents := g.checkSecurityEventsAccess(logger, httpclient)
+	if !securityEvents {
+		sdk.LogInfo(logger, "token doesn't have security_events access, skipping security alerts")
+	}
+	e := &repoExport{
+		logger:           logger,
+		client:           client,
+		httpclient:       httpclient,
+		export:           export,
+		userManager:      userManager,
+		checkpoints:      checkpoints,
+		repos:            repos,
+		forceIncremental: forceIncremental,
+		retryRepos:       retryRepos,
+		errors:           &exportErrors{},
+		securityEvents:   securityEvents,
+		issueTypes:       issueTypes,
+		priorities:       priorities,
+		jobs:             make([]repoJob, 0),
+		previousRepos:    previousRepos,
+		previousProjects: previousProjects,
+	}
 
-		if hookInstalled && !export.Historical() && !forceIncremental {
-			// if the hook is installed this isn't a historical, we can skip processing this repo
-			sdk.LogDebug(logger, "skipping repo since a webhook is already installed and not historical", "name", node.Name, "id", node.ID)
-			continue
-		}
-		if err := pipe.Write(repo); err != nil {
-			return err
-		}
-		if project != nil {
-			if err := pipe.Write(project); err != nil {
-				return err
-			}
-		}
-		if capability != nil {
-			if err := pipe.Write(capability); err != nil {
+	// export the teams for each org, these are exported in full each time since there aren't many of them
+	teamOrgs := orgs
+	if len(teamOrgs) > 0 && !g.checkReadOrgAccess(logger, httpclient) {
+		sdk.LogInfo(logger, "token doesn't have read:org access, skipping teams")
+		teamOrgs = nil
+	}
+	for _, login := range teamOrgs {
+		count, err := g.exportTeams(logger, client, export, userManager, login)
+		if err != nil {
+			if isFatalExportError(err) {
 				return err
 			}
+			sdk.LogError(logger, "error exporting teams", "org", login, "err", err)
+			e.errors.Add(login, exportErrorEntityTeams, "", err)
+			continue
 		}
+		sdk.LogDebug(logger, "exported teams", "org", login, "count", count)
+	}
 
-		// write out any labels as issue types
-		for _, labelnode := range node.Labels.Nodes {
-			o, err := labelnode.ToModel(logger, state, customerID, instanceID, export.Historical())
-			if err != nil {
+	if err := runParallel(concurrency, len(therepos), func(i int) error {
+		if err := g.exportRepo(e, therepos[i]); err != nil {
+			if isFatalExportError(err) {
 				return err
 			}
-			if o != nil {
-				if err := pipe.Write(o); err != nil {
-					return err
-				}
-			}
-		}
-
-		for _, predge := range node.Pullrequests.Edges {
-			pullrequest, err := predge.Node.ToModel(logger, userManager, customerID, repo.Name, repo.ID)
-			if err != nil {
-				return fmt.Errorf("failed to convert pull request to model: %w", err)
-			}
-			for _, reviewedge := range predge.Node.Reviews.Edges {
-				prreview, err := reviewedge.Node.ToModel(logger, userManager, customerID, repo.ID, pullrequest.ID)
-				if err != nil {
-					return err
-				}
-				if err := pipe.Write(prreview); err != nil {
-					return fmt.Errorf("error fetching review for pull request %s for repo: %v. %w", pullrequest.ID, r.Name, err)
-				}
-				reviewCount++
-			}
-			if predge.Node.Reviews.PageInfo.HasNextPage {
-				jobs = append(jobs, g.queuePullRequestReviewsJob(logger, client, userManager, r.Name, repo.GetID(), pullrequest.ID, predge.Node.Number, predge.Node.Reviews.PageInfo.EndCursor))
-			}
-			for _, reviewRequestedge := range predge.Node.ReviewRequests.Edges {
-				prreview, err := reviewRequestedge.Node.ToModel(logger, userManager, customerID, repo.ID, pullrequest.ID, predge.Node.UpdatedAt)
-				if err != nil {
-					return err
-				}
-				if err := pipe.Write(prreview); err != nil {
-					return fmt.Errorf("error writing review request for pull request %s for repo: %v. %w", pullrequest.ID, r.Name, err)
-				}
-				reviewRequestCount++
-			}
-			if predge.Node.ReviewRequests.PageInfo.HasNextPage {
-				// TODO(robin): queue job if has nextpage, for prs with >10 reviewers requested
-			}
-			for _, commentedge := range predge.Node.Comments.Edges {
-				prcomment, err := commentedge.Node.ToModel(logger, userManager, customerID, repo.ID, pullrequest.ID)
-				if err != nil {
-					return err
-				}
-				if err := pipe.Write(prcomment); err != nil {
-					return fmt.Errorf("error fetching comment for pull request %s for repo: %v. %w", pullrequest.ID, r.Name, err)
-				}
-				commentCount++
-			}
-			if predge.Node.Comments.PageInfo.HasNextPage {
-				jobs = append(jobs, g.queuePullRequestCommentsJob(logger, client, userManager, r.Name, repo.GetID(), pullrequest.ID, predge.Node.Number, predge.Node.Comments.PageInfo.EndCursor))
-			}
-			commits := make([]*sdk.SourceCodePullRequestCommit, 0)
-			for _, commitedge := range predge.Node.Commits.Edges {
-				prcommit, err := commitedge.Node.Commit.ToModel(logger, userManager, customerID, repo.ID, pullrequest.ID)
-				if err != nil {
-					return err
-				}
-				commits = append(commits, prcommit)
-			}
-			if predge.Node.Commits.PageInfo.HasNextPage {
-				// fetch all the remaining paged commits
-				morecommits, err := g.fetchPullRequestCommits(logger, client, userManager, export, customerID, repo.Name, predge.Node.ID, pullrequest.RepoID, predge.Node.Commits.PageInfo.EndCursor)
-				if err != nil {
-					return fmt.Errorf("error fetching commits for pull request %s for repo: %v. %w", pullrequest.ID, r.Name, err)
-				}
-				commits = append(commits, morecommits...)
-				sdk.LogDebug(logger, "fetched pull request commits", "count", len(commits), "pullrequest_id", predge.Node.ID, "repo", repo.Name)
-			}
-			// set the commits back on the pull request
-			setPullRequestCommits(pullrequest, commits)
-			// stream out all our commits
-			for _, commit := range commits {
-				if err := pipe.Write(commit); err != nil {
-					return err
-				}
-				commitCount++
-			}
-			// stream out our pullrequest
-			if err := pipe.Write(pullrequest); err != nil {
-				return err
-			}
-			prCount++
-		}
-
-		if r.HasIssuesEnabled {
-			sdk.LogDebug(logger, "issues enabled for this repo", "name", node.Name)
-			if err := g.fetchAllRepoIssues(logger, client, userManager, export, r.Name, r.ID, export.Historical()); err != nil {
-				return fmt.Errorf("error fetching repo issues: %w", err)
-			}
-			if err := g.fetchAllRepoMilestones(logger, client, userManager, export, r.Name, r.ID, export.Historical()); err != nil {
-				return fmt.Errorf("error fetching repo milestones: %w", err)
-			}
-		}
-
-		if project != nil && r.HasProjectsEnabled {
-			sdk.LogDebug(logger, "projects enabled for this repo", "name", node.Name)
-			if err := g.fetchRepoProjects(logger, client, export, r.Name, r.ID); err != nil {
-				return fmt.Errorf("error fetching repo projects: %w", err)
-			}
-		}
-
-		// NOTE: in an incremental this cursor should be where we last left off, so we will get
-		// all prs (newest to oldest) before this cursor
-		var beforeCursor string
-		if !export.Historical() {
-			found, err := state.Get(g.getRepoKey(repo.Name), &beforeCursor)
-			if err != nil {
-				return fmt.Errorf("error getting before cursor for incremental: %w", err)
-			}
-			if !found {
-				// this is not terrible it just means we dont know how far back we need to export prs
-				sdk.LogWarn(logger, "no before cursor found for incremental, all prs will be exported", "repo", repo.Name)
-			}
-		}
-		// save off where we started at so we can page from there in subsequent exports
-		if err := state.Set(g.getRepoKey(repo.Name), node.Pullrequests.PageInfo.StartCursor); err != nil {
-			return fmt.Errorf("error saving repo state: %w", err)
-		}
-		if node.Pullrequests.PageInfo.HasNextPage {
-			// queue the pull requests for the next page
-			jobs = append(jobs, g.queuePullRequestJob(logger, client, userManager, export.Historical(), r.Name, repo.GetID(), beforeCursor, node.Pullrequests.PageInfo.EndCursor))
+			// keep going with the other repos, this one will be retried on the next export
+			sdk.LogError(logger, "error exporting repo", "name", therepos[i].Name, "err", err)
+			e.errors.Add(therepos[i].Name, exportErrorEntityRepo, therepos[i].ID, err)
 		}
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	// remember the repos and projects we processed
@@ -1230,51 +1284,72 @@ func (g *GithubIntegration) Export(export sdk.Export) error {
 	}
 	sdk.LogDebug(logger, "saved previous state", "repos", len(previousRepos), "projects", len(previousProjects))
 
-	sdk.LogInfo(logger, "initial export completed", "duration", time.Since(started), "repoCount", repoCount, "prCount", prCount, "reviewCount", reviewCount, "reviewRequestCount", reviewRequestCount, "commitCount", commitCount, "commentCount", commentCount, "jobs", len(jobs))
+	sdk.LogInfo(logger, "initial export completed", "duration", time.Since(started), "repoCount", e.repoCount, "prCount", e.prCount, "reviewCount", e.reviewCount, "reviewRequestCount", e.reviewRequestCount, "commitCount", e.commitCount, "commentCount", e.commentCount, "jobs", len(e.jobs))
 
 	_, skipHistorical := g.config.GetBool("skip-historical")
 
-	if !skipHistorical && len(jobs) > 0 {
+	if !skipHistorical && len(e.jobs) > 0 {
 
 		// flush any pending data to get it to send immediately
 		pipe.Flush()
 
 		// now cycle through any pending jobs after the first pass
-		var wg sync.WaitGroup
-		var maxSize = 2
-		jobch := make(chan job, maxSize*5)
-		errors := make(chan error, maxSize)
-		// run our jobs in parallel but we're going to run the graphql request in single threaded mode to try
-		// and reduce abuse from GitHub but at least the processing can be done parallel on our side
-		for i := 0; i < maxSize; i++ {
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				for job := range jobch {
-					if err := job(export, pipe); err != nil {
-						sdk.LogError(logger, "error running job", "err", err)
-						errors <- err
-						return
-					}
-					// docs say a min of one second between requests
-					// https://developer.github.com/v3/guides/best-practices-for-integrators/#dealing-with-abuse-rate-limits
-					time.Sleep(time.Second)
+		if err := runParallel(concurrency, len(e.jobs), func(i int) error {
+			if err := e.jobs[i].run(export, pipe); err != nil {
+				sdk.LogError(logger, "error running job", "repo", e.jobs[i].repo, "err", err)
+				if isFatalExportError(err) {
+					return err
 				}
-			}()
+				e.errors.Add(e.jobs[i].repo, exportErrorEntityJob, "", err)
+			}
+			// docs say a min of one second between requests
+			// https://developer.github.com/v3/guides/best-practices-for-integrators/#dealing-with-abuse-rate-limits
+			time.Sleep(time.Second)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	// remember the repos which stopped early so they are exported in full on the next export. errors
+	// for a single entity, such as one pull request, are only logged so they don't reset the whole repo
+	failedRepos := make(map[string]bool)
+	for _, name := range e.errors.Repos(exportErrorEntityRepo, exportErrorEntityJob) {
+		if _, ok := repos[name]; !ok {
+			// not a repo, such as the teams for an org
+			continue
 		}
-		for _, job := range jobs {
-			jobch <- job
+		failedRepos[name] = true
+		if err := g.resetRepoState(state, name); err != nil {
+			sdk.LogError(logger, "error resetting repo state", "name", name, "err", err)
 		}
-		// close and wait for all our jobs to complete
-		close(jobch)
-		wg.Wait()
-		// check to see if we had an early exit
-		select {
-		case err := <-errors:
-			return err
-		default:
+	}
+	if len(failedRepos) > 0 {
+		if err := state.Set(failedReposStateKey, failedRepos); err != nil {
+			return fmt.Errorf("error saving failed repos state: %w", err)
+		}
+	} else if err := state.Delete(failedReposStateKey); err != nil {
+		return fmt.Errorf("error removing failed repos state: %w", err)
+	}
+
+	// the export has completed so the next historical should start over, except for
+	// the repos which stopped early which can resume where they stopped
+	stopped := make(map[string]bool)
+	for _, name := range e.errors.Repos(exportErrorEntityRepo, exportErrorEntityJob) {
+		stopped[name] = true
+	}
+	exported := make([]string, 0, len(therepos))
+	for _, node := range therepos {
+		if !stopped[node.Name] {
+			exported = append(exported, node.Name)
 		}
 	}
+	checkpoints.Clear(logger, exported)
+
+	if e.errors.Len() > 0 {
+		e.errors.Log(logger)
+		return e.errors.Err(exportErrorEntityRepo, exportErrorEntityJob)
+	}
 
 	return nil
 }
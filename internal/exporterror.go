@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+const (
+	failedReposStateKey = "failed_repos"
+	// maxExportErrorsReported is the max number of errors included in the error returned from an export
+	maxExportErrorsReported = 5
+
+	// exportErrorEntityRepo and exportErrorEntityJob are errors which stopped the export of a repo early
+	exportErrorEntityRepo = "repo"
+	exportErrorEntityJob  = "job"
+
+	// exportErrorEntityTeams is an error exporting the teams for an org
+	exportErrorEntityTeams = "teams"
+)
+
+// exportError is an error exporting an entity for a repo
+// easyjson:skip
+type exportError struct {
+	Repo   string
+	Entity string
+	RefID  string
+	Err    error
+}
+
+func (e *exportError) Error() string {
+	if e.RefID != "" {
+		return fmt.Sprintf("error exporting %s %s for %s: %s", e.Entity, e.RefID, e.Repo, e.Err)
+	}
+	return fmt.Sprintf("error exporting %s for %s: %s", e.Entity, e.Repo, e.Err)
+}
+
+func (e *exportError) Unwrap() error {
+	return e.Err
+}
+
+// isFatalExportError returns true if the error means that no other repo can be exported either
+func isFatalExportError(err error) bool {
+	return isQueryErrorType(err, queryErrorAuth)
+}
+
+// exportErrors collects the errors for an export so that one failing repo doesn't stop the others
+// easyjson:skip
+type exportErrors struct {
+	lock   sync.Mutex
+	errors []*exportError
+}
+
+// Add will record an error for the entity in the repo
+func (e *exportErrors) Add(repo string, entity string, refID string, err error) {
+	var eerr *exportError
+	if !errors.As(err, &eerr) {
+		eerr = &exportError{Repo: repo, Entity: entity, RefID: refID, Err: err}
+	}
+	e.lock.Lock()
+	e.errors = append(e.errors, eerr)
+	e.lock.Unlock()
+}
+
+// Len returns the number of errors
+func (e *exportErrors) Len() int {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return len(e.errors)
+}
+
+// matchEntity returns true if entities is empty or contains entity
+func matchEntity(entity string, entities []string) bool {
+	if len(entities) == 0 {
+		return true
+	}
+	for _, e := range entities {
+		if e == entity {
+			return true
+		}
+	}
+	return false
+}
+
+// Repos returns the sorted names of the repos which had an error, optionally only for errors for one of entities
+func (e *exportErrors) Repos(entities ...string) []string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	found := make(map[string]bool)
+	repos := make([]string, 0)
+	for _, err := range e.errors {
+		if !matchEntity(err.Entity, entities) {
+			continue
+		}
+		if !found[err.Repo] {
+			found[err.Repo] = true
+			repos = append(repos, err.Repo)
+		}
+	}
+	sort.Strings(repos)
+	return repos
+}
+
+// Log will log each error and a summary of the errors by entity
+func (e *exportErrors) Log(logger sdk.Logger) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	entities := make(map[string]int)
+	for _, err := range e.errors {
+		entities[err.Entity]++
+		sdk.LogWarn(logger, "export error", "repo", err.Repo, "entity", err.Entity, "ref_id", err.RefID, "err", err.Err)
+	}
+	sdk.LogError(logger, "export completed with errors", "count", len(e.errors), "entities", entities)
+}
+
+// Err returns an error which summarizes all the errors, optionally only for errors for one of entities, or nil if there were none
+func (e *exportErrors) Err(entities ...string) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	errs := make([]*exportError, 0, len(e.errors))
+	for _, err := range e.errors {
+		if matchEntity(err.Entity, entities) {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	msgs := make([]string, 0, maxExportErrorsReported)
+	for i, err := range errs {
+		if i == maxExportErrorsReported {
+			msgs = append(msgs, fmt.Sprintf("and %d more", len(errs)-i))
+			break
+		}
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Errorf("export completed with %d errors: %s", len(errs), strings.Join(msgs, "; "))
+}
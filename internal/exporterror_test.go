@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportErrors(t *testing.T) {
+	assert := assert.New(t)
+	var errs exportErrors
+	assert.NoError(errs.Err())
+	errs.Add("pinpt/b", "issue", "1", errors.New("bad issue"))
+	errs.Add("pinpt/a", exportErrorEntityRepo, "2", errors.New("bad repo"))
+	errs.Add("pinpt/b", "pull request", "3", errors.New("bad pr"))
+	assert.Equal(3, errs.Len())
+	assert.Equal([]string{"pinpt/a", "pinpt/b"}, errs.Repos())
+	assert.Equal([]string{"pinpt/a"}, errs.Repos(exportErrorEntityRepo, exportErrorEntityJob))
+	assert.EqualError(errs.Err(exportErrorEntityRepo, exportErrorEntityJob), "export completed with 1 errors: error exporting repo 2 for pinpt/a: bad repo")
+	assert.NoError(errs.Err(exportErrorEntityJob))
+	assert.EqualError(errs.Err(), "export completed with 3 errors: error exporting issue 1 for pinpt/b: bad issue; error exporting repo 2 for pinpt/a: bad repo; error exporting pull request 3 for pinpt/b: bad pr")
+}
+
+func TestExportErrorsWrapped(t *testing.T) {
+	assert := assert.New(t)
+	var errs exportErrors
+	errs.Add("pinpt/a", exportErrorEntityJob, "", fmt.Errorf("error running job: %w", &exportError{Repo: "pinpt/b", Entity: "issue", RefID: "1", Err: errors.New("boom")}))
+	assert.Equal([]string{"pinpt/b"}, errs.Repos())
+	assert.True(isFatalExportError(fmt.Errorf("error fetching repo issues: %w", &queryError{Type: queryErrorAuth, Err: errors.New("Bad credentials")})))
+	assert.False(isFatalExportError(&queryError{Type: queryErrorForbidden, Err: errors.New("Resource not accessible by integration")}))
+	assert.False(isFatalExportError(errors.New("boom")))
+}
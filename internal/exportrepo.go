@@ -0,0 +1,477 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+const defaultExportConcurrency = 2
+
+// repoExport is the state shared by all the repos being exported in parallel
+// easyjson:skip
+type repoExport struct {
+	logger           sdk.Logger
+	client           sdk.GraphQLClient
+	httpclient       sdk.HTTPClient
+	export           sdk.Export
+	userManager      *UserManager
+	checkpoints      *exportCheckpoints
+	repos            map[string]repoName
+	forceIncremental bool
+	retryRepos       map[string]bool
+	errors           *exportErrors
+	securityEvents   bool
+	issueTypes       issueTypeMapping
+	priorities       issuePriorityMapping
+
+	lock               sync.Mutex
+	jobs               []repoJob
+	previousRepos      map[string]*sdk.SourceCodeRepo
+	previousProjects   map[string]*sdk.WorkProject
+	repoCount          int
+	prCount            int
+	reviewCount        int
+	reviewRequestCount int
+	commitCount        int
+	commentCount       int
+}
+
+// repoJob is a job for a repo which will run after all the repos have been exported
+type repoJob struct {
+	repo string
+	run  job
+}
+
+// queue a job to run once all the repos have been exported
+func (e *repoExport) queue(repoName string, j job) {
+	e.lock.Lock()
+	e.jobs = append(e.jobs, repoJob{repoName, j})
+	e.lock.Unlock()
+}
+
+// remember the repo and project so we can detect when they are removed in a subsequent export
+func (e *repoExport) remember(repo *sdk.SourceCodeRepo, project *sdk.WorkProject) {
+	e.lock.Lock()
+	e.previousRepos[repo.Name] = repo
+	if project != nil {
+		e.previousProjects[repo.ID] = project
+	}
+	e.lock.Unlock()
+}
+
+// newCheckpointJob returns the job to run for the checkpointed job, which removes it from the checkpoint once it has run
+func (g *GithubIntegration) newCheckpointJob(e *repoExport, repoName, repoID, projectID string, j checkpointJob) job {
+	var run job
+	switch j.Type {
+	case checkpointJobPullRequestReviews:
+		run = g.queuePullRequestReviewsJob(e.logger, e.client, e.userManager, repoName, repoID, j.ID, j.Number, j.Cursor)
+	case checkpointJobPullRequestReviewRequests:
+		run = g.queuePullRequestReviewRequestsJob(e.logger, e.client, e.userManager, repoName, repoID, j.ID, j.Number, j.UpdatedAt, j.Cursor)
+	case checkpointJobPullRequestComments:
+		run = g.queuePullRequestCommentsJob(e.logger, e.client, e.userManager, repoName, repoID, j.ID, j.Number, j.Cursor)
+	case checkpointJobPullRequestReviewThreads:
+		run = g.queuePullRequestReviewThreadsJob(e.logger, e.client, e.userManager, e.errors, repoName, repoID, j.ID, j.Number, j.Cursor)
+	case checkpointJobIssueComments:
+		run = g.queueIssueCommentsJob(e.logger, e.client, e.userManager, repoName, projectID, j.ID, j.Number, j.Cursor)
+	default:
+		return func(export sdk.Export, pipe sdk.Pipe) error {
+			return fmt.Errorf("unknown checkpoint job type: %s", j.Type)
+		}
+	}
+	return func(export sdk.Export, pipe sdk.Pipe) error {
+		if err := run(export, pipe); err != nil {
+			return err
+		}
+		return e.checkpoints.RemoveJob(repoName, j)
+	}
+}
+
+// queueCheckpointJob will save the job to the repo's checkpoint before queueing it so that the pages left
+// to the job aren't lost if the export stops before it has run and its stage is already completed
+func (g *GithubIntegration) queueCheckpointJob(e *repoExport, repoName, repoID, projectID string, j checkpointJob) error {
+	added, err := e.checkpoints.AddJob(repoName, j)
+	if err != nil {
+		return err
+	}
+	if added {
+		e.queue(repoName, g.newCheckpointJob(e, repoName, repoID, projectID, j))
+	}
+	return nil
+}
+
+func (e *repoExport) count(counter *int) {
+	e.lock.Lock()
+	*counter++
+	e.lock.Unlock()
+}
+
+// getExportConcurrency returns the number of repos to export in parallel from the instance config, falling back to the integration config
+func (g *GithubIntegration) getExportConcurrency(config sdk.Config) int {
+	ok, val := config.GetInt("concurrency")
+	if !ok {
+		ok, val = g.config.GetInt("concurrency")
+	}
+	if !ok || val < 1 {
+		return defaultExportConcurrency
+	}
+	return int(val)
+}
+
+// sortReposByUpdated will sort the repos so the most recently updated are exported first
+func sortReposByUpdated(repos []repository) {
+	sort.SliceStable(repos, func(i, j int) bool {
+		return repos[i].UpdatedAt.After(repos[j].UpdatedAt)
+	})
+}
+
+func (g *GithubIntegration) exportRepo(e *repoExport, node repository) error {
+	logger := e.logger
+	client := e.client
+	export := e.export
+	pipe := export.Pipe()
+	state := export.State()
+	customerID := export.CustomerID()
+	instanceID := export.IntegrationInstanceID()
+	userManager := e.userManager
+	checkpoints := e.checkpoints
+
+	sdk.LogInfo(logger, "processing repo: "+node.Name, "id", node.ID)
+
+	e.count(&e.repoCount)
+	r := e.repos[node.Name]
+
+	hookInstalled, err := g.installRepoWebhookIfRequired(g.manager.WebHookManager(), logger, e.httpclient, customerID, instanceID, r.Login, r.Name, r.ID)
+	if err != nil {
+		return err
+	}
+
+	if node.Labels.PageInfo.HasNextPage {
+		labels, err := g.fetchRepoLabels(logger, client, export, node.Name, node.Labels.PageInfo.EndCursor)
+		if err != nil {
+			return fmt.Errorf("error fetching labels: %w", err)
+		}
+		node.Labels.Nodes = append(node.Labels.Nodes, labels...)
+	}
+
+	repo, project, capability := node.ToModel(export.State(), export.Historical(), e.issueTypes, e.priorities, customerID, instanceID, r.Login, r.IsPrivate, r.Scope)
+
+	e.remember(repo, project)
+
+	if hookInstalled && !export.Historical() && !e.forceIncremental && !e.retryRepos[node.Name] {
+		// if the hook is installed this isn't a historical, we can skip processing this repo
+		sdk.LogDebug(logger, "skipping repo since a webhook is already installed and not historical", "name", node.Name, "id", node.ID)
+		return nil
+	}
+	checkpoint, err := checkpoints.Get(node.Name)
+	if err != nil {
+		return err
+	}
+	if checkpoint.Completed() {
+		sdk.LogInfo(logger, "skipping repo which was already exported by a previous run", "name", node.Name, "id", node.ID)
+		return nil
+	}
+	projectID := sdk.NewWorkProjectID(customerID, r.ID, refType)
+	// queue the jobs which a previous run didn't finish since their stages won't be exported again
+	for _, j := range checkpoint.Jobs {
+		e.queue(r.Name, g.newCheckpointJob(e, r.Name, repo.ID, projectID, j))
+	}
+	if !checkpoint.Repo.Completed {
+		if err := pipe.Write(repo); err != nil {
+			return err
+		}
+		if project != nil {
+			if err := pipe.Write(project); err != nil {
+				return err
+			}
+		}
+		if capability != nil {
+			if err := pipe.Write(capability); err != nil {
+				return err
+			}
+		}
+
+		// write out any labels as issue types
+		for _, labelnode := range node.Labels.Nodes {
+			o, err := labelnode.ToModel(logger, state, e.issueTypes, customerID, instanceID, export.Historical())
+			if err != nil {
+				e.errors.Add(r.Name, "label", labelnode.ID, err)
+				continue
+			}
+			if o != nil {
+				if err := pipe.Write(o); err != nil {
+					return err
+				}
+			}
+		}
+		if err := checkpoints.Update(node.Name, func(checkpoint *repoCheckpoint) { checkpoint.Repo.Completed = true }); err != nil {
+			return err
+		}
+	}
+
+	// if we have a cursor, a previous export already processed the first page of pull requests
+	if !checkpoint.PullRequests.Completed && checkpoint.PullRequests.Cursor == "" {
+		for _, predge := range node.Pullrequests.Edges {
+			pullrequest, err := predge.Node.ToModel(logger, userManager, customerID, repo.Name, repo.ID)
+			if err != nil {
+				e.errors.Add(r.Name, "pull request", predge.Node.ID, fmt.Errorf("failed to convert pull request to model: %w", err))
+				continue
+			}
+			for _, reviewedge := range predge.Node.Reviews.Edges {
+				prreview, err := reviewedge.Node.ToModel(logger, userManager, customerID, repo.ID, pullrequest.ID)
+				if err != nil {
+					e.errors.Add(r.Name, "pull request review", reviewedge.Node.ID, err)
+					continue
+				}
+				if err := pipe.Write(prreview); err != nil {
+					return fmt.Errorf("error fetching review for pull request %s for repo: %v. %w", pullrequest.ID, r.Name, err)
+				}
+				e.count(&e.reviewCount)
+			}
+			if predge.Node.Reviews.PageInfo.HasNextPage {
+				if err := g.queueCheckpointJob(e, r.Name, repo.ID, projectID, checkpointJob{Type: checkpointJobPullRequestReviews, ID: pullrequest.ID, Number: predge.Node.Number, Cursor: predge.Node.Reviews.PageInfo.EndCursor}); err != nil {
+					return err
+				}
+			}
+			for _, reviewRequestedge := range predge.Node.ReviewRequests.Edges {
+				prreviewrequests, err := reviewRequestedge.Node.ToModel(logger, client, userManager, customerID, repo.ID, pullrequest.ID, predge.Node.UpdatedAt)
+				if err != nil {
+					if isFatalExportError(err) {
+						return err
+					}
+					e.errors.Add(r.Name, "pull request review request", reviewRequestedge.Node.ID, err)
+					continue
+				}
+				for _, prreviewrequest := range prreviewrequests {
+					if err := pipe.Write(prreviewrequest); err != nil {
+						return fmt.Errorf("error writing review request for pull request %s for repo: %v. %w", pullrequest.ID, r.Name, err)
+					}
+					e.count(&e.reviewRequestCount)
+				}
+			}
+			if predge.Node.ReviewRequests.PageInfo.HasNextPage {
+				if err := g.queueCheckpointJob(e, r.Name, repo.ID, projectID, checkpointJob{Type: checkpointJobPullRequestReviewRequests, ID: pullrequest.ID, Number: predge.Node.Number, Cursor: predge.Node.ReviewRequests.PageInfo.EndCursor, UpdatedAt: predge.Node.UpdatedAt}); err != nil {
+					return err
+				}
+			}
+			for _, commentedge := range predge.Node.Comments.Edges {
+				prcomment, err := commentedge.Node.ToModel(logger, userManager, customerID, repo.ID, pullrequest.ID)
+				if err != nil {
+					e.errors.Add(r.Name, "pull request comment", commentedge.Node.ID, err)
+					continue
+				}
+				if err := pipe.Write(prcomment); err != nil {
+					return fmt.Errorf("error fetching comment for pull request %s for repo: %v. %w", pullrequest.ID, r.Name, err)
+				}
+				e.count(&e.commentCount)
+			}
+			if predge.Node.Comments.PageInfo.HasNextPage {
+				if err := g.queueCheckpointJob(e, r.Name, repo.ID, projectID, checkpointJob{Type: checkpointJobPullRequestComments, ID: pullrequest.ID, Number: predge.Node.Number, Cursor: predge.Node.Comments.PageInfo.EndCursor}); err != nil {
+					return err
+				}
+			}
+			for _, thread := range predge.Node.ReviewThreads.Nodes {
+				comments, err := g.reviewThreadComments(logger, client, userManager, export, customerID, repo.ID, pullrequest.ID, thread)
+				if err != nil {
+					if isFatalExportError(err) {
+						return err
+					}
+					e.errors.Add(r.Name, "pull request review thread", thread.ID, err)
+					continue
+				}
+				for _, comment := range comments {
+					if err := pipe.Write(comment); err != nil {
+						return fmt.Errorf("error writing review comment for pull request %s for repo: %v. %w", pullrequest.ID, r.Name, err)
+					}
+					e.count(&e.commentCount)
+				}
+			}
+			if predge.Node.ReviewThreads.PageInfo.HasNextPage {
+				if err := g.queueCheckpointJob(e, r.Name, repo.ID, projectID, checkpointJob{Type: checkpointJobPullRequestReviewThreads, ID: pullrequest.ID, Number: predge.Node.Number, Cursor: predge.Node.ReviewThreads.PageInfo.EndCursor}); err != nil {
+					return err
+				}
+			}
+			commits := make([]*sdk.SourceCodePullRequestCommit, 0)
+			for _, commitedge := range predge.Node.Commits.Edges {
+				prcommit, err := commitedge.Node.Commit.ToModel(logger, userManager, customerID, repo.ID, pullrequest.ID)
+				if err != nil {
+					e.errors.Add(r.Name, "pull request commit", commitedge.Node.Commit.Sha, err)
+					continue
+				}
+				commits = append(commits, prcommit)
+			}
+			if predge.Node.Commits.PageInfo.HasNextPage {
+				// fetch all the remaining paged commits
+				morecommits, err := g.fetchPullRequestCommits(logger, client, userManager, export, customerID, repo.Name, predge.Node.ID, pullrequest.RepoID, predge.Node.Commits.PageInfo.EndCursor)
+				if err != nil {
+					if isFatalExportError(err) {
+						return err
+					}
+					// export the pull request with its first page of commits rather than dropping it
+					e.errors.Add(r.Name, "pull request commits", predge.Node.ID, err)
+				} else {
+					commits = append(commits, morecommits...)
+					sdk.LogDebug(logger, "fetched pull request commits", "count", len(commits), "pullrequest_id", predge.Node.ID, "repo", repo.Name)
+				}
+			}
+			// set the commits back on the pull request
+			setPullRequestCommits(pullrequest, commits)
+			if err := g.setPullRequestIssues(logger, client, export, pullrequest, r.Name, predge.Node.ClosingIssues, pullRequestTexts(predge.Node.Title, predge.Node.RawBody, commits)...); err != nil {
+				return err
+			}
+			// export the pull request without its files rather than dropping it
+			if err := g.setPullRequestFiles(logger, client, export, pullrequest, r.Name, predge.Node.Number, predge.Node.Files); err != nil {
+				if isFatalExportError(err) {
+					return err
+				}
+				e.errors.Add(r.Name, "pull request files", predge.Node.ID, err)
+			}
+			// export the pull request without its events rather than dropping it
+			if err := g.setPullRequestEvents(logger, client, userManager, export, pullrequest, predge.Node.TimelineItems); err != nil {
+				if isFatalExportError(err) {
+					return err
+				}
+				e.errors.Add(r.Name, "pull request timeline", predge.Node.ID, err)
+			}
+			// stream out all our commits
+			for _, commit := range commits {
+				if err := pipe.Write(commit); err != nil {
+					return err
+				}
+				e.count(&e.commitCount)
+			}
+			for _, build := range predge.Node.HeadCommit.StatusBuilds(customerID, instanceID, r.Name, repo.ID, predge.Node.Branch) {
+				if err := pipe.Write(build); err != nil {
+					return err
+				}
+			}
+			// stream out our pullrequest
+			if err := pipe.Write(pullrequest); err != nil {
+				return err
+			}
+			e.count(&e.prCount)
+		}
+	}
+
+	if r.HasIssuesEnabled {
+		sdk.LogDebug(logger, "issues enabled for this repo", "name", node.Name)
+		if !checkpoint.Issues.Completed {
+			if err := g.fetchAllRepoIssues(logger, client, userManager, e.issueTypes, e.priorities, checkpoints, e.errors, export, r.Name, r.ID, export.Historical(), func(j checkpointJob) error { return g.queueCheckpointJob(e, r.Name, repo.ID, projectID, j) }); err != nil {
+				return fmt.Errorf("error fetching repo issues: %w", err)
+			}
+		}
+		if !checkpoint.Milestones.Completed {
+			if err := g.fetchAllRepoMilestones(logger, client, userManager, checkpoints, e.errors, export, r.Name, r.ID, export.Historical()); err != nil {
+				return fmt.Errorf("error fetching repo milestones: %w", err)
+			}
+		}
+	}
+
+	if project != nil && r.HasProjectsEnabled && !checkpoint.Projects.Completed {
+		sdk.LogDebug(logger, "projects enabled for this repo", "name", node.Name)
+		if err := g.fetchRepoProjects(logger, client, export, r.Name, r.ID); err != nil {
+			return fmt.Errorf("error fetching repo projects: %w", err)
+		}
+	}
+	if err := checkpoints.Update(node.Name, func(checkpoint *repoCheckpoint) {
+		checkpoint.Issues.Completed = true
+		checkpoint.Milestones.Completed = true
+		checkpoint.Projects.Completed = true
+	}); err != nil {
+		return err
+	}
+
+	if !checkpoint.Commits.Completed {
+		if err := g.fetchDefaultBranchCommits(logger, client, userManager, checkpoints, e.errors, export, r.Name, repo.ID, export.Historical()); err != nil {
+			return fmt.Errorf("error fetching default branch commits: %w", err)
+		}
+	}
+	if !checkpoint.CommitComments.Completed {
+		if err := g.exportCommitComments(logger, e.httpclient, userManager, e.errors, export, r.Name, repo.ID, export.Historical()); err != nil {
+			return fmt.Errorf("error fetching commit comments: %w", err)
+		}
+		if err := checkpoints.Update(node.Name, func(checkpoint *repoCheckpoint) { checkpoint.CommitComments.Completed = true }); err != nil {
+			return err
+		}
+	}
+	if !checkpoint.Branches.Completed {
+		if err := g.exportBranches(logger, client, e.httpclient, export, r.Name, repo.ID, repo.URL); err != nil {
+			return fmt.Errorf("error fetching branches: %w", err)
+		}
+		if err := checkpoints.Update(node.Name, func(checkpoint *repoCheckpoint) { checkpoint.Branches.Completed = true }); err != nil {
+			return err
+		}
+	}
+	if !checkpoint.Releases.Completed {
+		if err := g.exportReleases(logger, client, userManager, e.errors, export, r.Name, repo.ID, repo.URL, export.Historical()); err != nil {
+			return fmt.Errorf("error fetching releases: %w", err)
+		}
+		if err := checkpoints.Update(node.Name, func(checkpoint *repoCheckpoint) { checkpoint.Releases.Completed = true }); err != nil {
+			return err
+		}
+	}
+	if !checkpoint.Deployments.Completed {
+		if err := g.exportDeployments(logger, client, e.httpclient, userManager, e.errors, export, r.Name, repo.ID, export.Historical()); err != nil {
+			return fmt.Errorf("error fetching deployments: %w", err)
+		}
+		if err := checkpoints.Update(node.Name, func(checkpoint *repoCheckpoint) { checkpoint.Deployments.Completed = true }); err != nil {
+			return err
+		}
+	}
+	if !checkpoint.Builds.Completed {
+		if err := g.exportBuilds(logger, e.httpclient, e.errors, export, r.Name, repo.ID, export.Historical()); err != nil {
+			return fmt.Errorf("error fetching builds: %w", err)
+		}
+		if err := checkpoints.Update(node.Name, func(checkpoint *repoCheckpoint) { checkpoint.Builds.Completed = true }); err != nil {
+			return err
+		}
+	}
+	if !checkpoint.SecurityAlerts.Completed {
+		if e.securityEvents {
+			if err := g.exportSecurityAlerts(logger, e.httpclient, userManager, e.errors, export, r.Name, repo.ID, export.Historical()); err != nil {
+				return fmt.Errorf("error fetching security alerts: %w", err)
+			}
+		}
+		if err := checkpoints.Update(node.Name, func(checkpoint *repoCheckpoint) { checkpoint.SecurityAlerts.Completed = true }); err != nil {
+			return err
+		}
+	}
+
+	// NOTE: in an incremental this cursor should be where we last left off, so we will get
+	// all prs (newest to oldest) before this cursor
+	var beforeCursor string
+	if !export.Historical() {
+		found, err := state.Get(g.getRepoKey(repo.Name), &beforeCursor)
+		if err != nil {
+			return fmt.Errorf("error getting before cursor for incremental: %w", err)
+		}
+		if !found {
+			// this is not terrible it just means we dont know how far back we need to export prs
+			sdk.LogWarn(logger, "no before cursor found for incremental, all prs will be exported", "repo", repo.Name)
+		}
+	}
+	// save off where we started at so we can page from there in subsequent exports
+	if err := state.Set(g.getRepoKey(repo.Name), node.Pullrequests.PageInfo.StartCursor); err != nil {
+		return fmt.Errorf("error saving repo state: %w", err)
+	}
+	if checkpoint.PullRequests.Completed {
+		return nil
+	}
+	afterCursor := checkpoint.PullRequests.Cursor
+	if afterCursor == "" && node.Pullrequests.PageInfo.HasNextPage {
+		afterCursor = node.Pullrequests.PageInfo.EndCursor
+	}
+	if err := checkpoints.Update(node.Name, func(checkpoint *repoCheckpoint) {
+		checkpoint.PullRequests.Cursor = afterCursor
+		checkpoint.PullRequests.Completed = afterCursor == ""
+	}); err != nil {
+		return err
+	}
+	if afterCursor != "" {
+		// queue the pull requests for the next page
+		e.queue(r.Name, g.queuePullRequestJob(logger, client, userManager, checkpoints, e.errors, export.Historical(), r.Name, repo.GetID(), beforeCursor, afterCursor))
+	}
+	return nil
+}
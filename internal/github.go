@@ -13,7 +13,9 @@ import (
 type GithubIntegration struct {
 	config  sdk.Config
 	manager sdk.Manager
-	lock    sync.Mutex
+
+	budgetLock sync.Mutex
+	budgets    map[string]*rateLimitBudget // rate limit budget by integration instance id
 
 	testClient sdk.GraphQLClient // only set in testing
 }
@@ -40,6 +42,7 @@ func (g *GithubIntegration) Enroll(instance sdk.Instance) error {
 		if err != nil {
 			return fmt.Errorf("error creating http client: %w", err)
 		}
+		client = g.getRateLimitBudget(instance.IntegrationInstanceID()).httpClient(logger, nil, client)
 		for login, acct := range *config.Accounts {
 			if acct.Selected != nil && !*acct.Selected {
 				continue
@@ -65,6 +68,7 @@ func (g *GithubIntegration) Dismiss(instance sdk.Instance) error {
 	if err != nil {
 		return fmt.Errorf("error creating http client: %w", err)
 	}
+	client = g.getRateLimitBudget(instance.IntegrationInstanceID()).httpClient(logger, nil, client)
 	if config.IntegrationType == sdk.CloudIntegration && config.OAuth2Auth != nil {
 		for login, acct := range *config.Accounts {
 			if acct.Type == sdk.ConfigAccountTypeOrg {
@@ -106,6 +110,9 @@ func (g *GithubIntegration) Dismiss(instance sdk.Instance) error {
 	// clean up our state keys
 	state.Delete(previousReposStateKey)
 	state.Delete(previousProjectsStateKey)
+	g.budgetLock.Lock()
+	delete(g.budgets, instance.IntegrationInstanceID())
+	g.budgetLock.Unlock()
 	sdk.LogInfo(logger, "dismiss completed", "duration", time.Since(started), "customer_id", instance.CustomerID(), "integration_instance_id", instance.IntegrationInstanceID())
 	return nil
 }
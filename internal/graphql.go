@@ -10,9 +10,10 @@ import (
 const refType = "github"
 
 type pageInfo struct {
-	HasNextPage bool   `json:"hasNextPage"`
-	StartCursor string `json:"startCursor"`
-	EndCursor   string `json:"endCursor"`
+	HasNextPage     bool   `json:"hasNextPage"`
+	HasPreviousPage bool   `json:"hasPreviousPage"`
+	StartCursor     string `json:"startCursor"`
+	EndCursor       string `json:"endCursor"`
 }
 
 type rateLimit struct {
@@ -24,7 +25,7 @@ type rateLimit struct {
 
 func (l rateLimit) ShouldPause() bool {
 	// stop at 80%
-	return float32(l.Remaining)*.8 >= float32(l.Limit)
+	return float32(l.Remaining) <= float32(l.Limit)*rateLimitPauseThreshold
 }
 
 type nameProp struct {
@@ -35,7 +36,11 @@ type oidProp struct {
 	Oid string `json:"oid"`
 }
 
-var pullrequestFields = `
+type idProp struct {
+	ID string `json:"id"`
+}
+
+var pullrequestFields = fmt.Sprintf(`
 	id
 	bodyHTML
 	url
@@ -48,6 +53,7 @@ var pullrequestFields = `
 	title
 	createdAt
 	updatedAt
+	closedAt
 	mergedAt
 	branch: headRefName
 	mergeCommit {
@@ -81,6 +87,9 @@ var pullrequestFields = `
 			id
 		}
 	}
+	timelineItems(first: 100, itemTypes: [%s]) {
+		%s
+	}
 	commits(first: 10) {
 		totalCount
 		pageInfo {
@@ -154,6 +163,12 @@ var pullrequestFields = `
 		}
 	}
 	reviewRequests (first: 10) {
+		totalCount
+		pageInfo {
+			hasNextPage
+			startCursor
+			endCursor
+		}
 		edges {
 			cursor
 			node {
@@ -166,6 +181,16 @@ var pullrequestFields = `
 						name
 						type: __typename
 					}
+					...on Team {
+						id
+						name
+						slug
+						url
+						organization {
+							login
+						}
+						type: __typename
+					}
 				}
 			}
 		}
@@ -202,7 +227,42 @@ var pullrequestFields = `
 			}
 		}
 	}
-`
+	headCommit: commits(last: 1) {
+		nodes {
+			commit {
+				oid
+				status {
+					contexts {
+						context
+						state
+						targetUrl
+						description
+						createdAt
+					}
+				}
+			}
+		}
+	}
+	reviewThreads(first: 10) {
+		totalCount
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			%s
+		}
+	}
+	files(first: 100) {
+		%s
+	}
+	rawBody: body
+	closingIssuesReferences(first: 10) {
+		nodes {
+			%s
+		}
+	}
+`, pullrequestTimelineItemTypes, pullrequestTimelineFields, fmt.Sprintf(reviewThreadFields, 10), pullrequestFileFields, issueReferenceFields)
 
 var pullrequestPagedQuery = fmt.Sprintf(`
 query GetPullRequests($name: String!, $owner: String!, $first: Int!, $after: String, $before: String) {
@@ -324,6 +384,54 @@ query GetPullRequestReviews($name: String!, $owner: String!, $first: Int!, $afte
 }
 `
 
+var pullrequestReviewRequestsPagedQuery = `
+query GetPullRequestReviewRequests($name: String!, $owner: String!, $first: Int!, $after: String, $number: Int!) {
+	repository(name: $name, owner: $owner) {
+		pullRequest(number: $number) {
+			reviewRequests(first: $first, after: $after) {
+				totalCount
+				pageInfo {
+					hasNextPage
+					startCursor
+					endCursor
+				}
+				edges {
+					cursor
+					node {
+						id
+						requestedReviewer {
+							...on User {
+								login
+								id
+								email
+								name
+								type: __typename
+							}
+							...on Team {
+								id
+								name
+								slug
+								url
+								organization {
+									login
+								}
+								type: __typename
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`
+
 type allOrgViewOrg struct {
 	Organizations organizations `json:"organizations"`
 }
@@ -634,7 +742,11 @@ func getAllRepoDataQuery(owner, name, label, cursor string) string {
 		owner {
 			login
 		}
-		labels(first: 20, orderBy:{field:CREATED_AT, direction:ASC}) {
+		labels(first: 100, orderBy:{field:CREATED_AT, direction:ASC}) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
 			nodes {
 				id
 				name
@@ -664,6 +776,7 @@ func getAllRepoDataQuery(owner, name, label, cursor string) string {
 					title
 					createdAt
 					updatedAt
+					closedAt
 					mergedAt
 					branch: headRefName
 					mergeCommit {
@@ -691,22 +804,8 @@ func getAllRepoDataQuery(owner, name, label, cursor string) string {
 							name
 						}
 					}
-					timelineItems(last: 1, itemTypes: CLOSED_EVENT) {
-						nodes {
-							... on ClosedEvent {
-								actor {
-									type: __typename
-									avatarUrl
-									login
-									url
-									... on User {
-										id
-										email
-										name
-									}
-								}
-							}
-						}
+					timelineItems(first: 100, itemTypes: [%s]) {
+						%s
 					}
 					commits(first: 10) {
 						totalCount
@@ -795,6 +894,16 @@ func getAllRepoDataQuery(owner, name, label, cursor string) string {
 										email
 										name
 									}
+									...on Team {
+										id
+										name
+										slug
+										url
+										organization {
+											login
+										}
+										type: __typename
+									}
 								}
 							}
 						}
@@ -833,115 +942,455 @@ func getAllRepoDataQuery(owner, name, label, cursor string) string {
 							name
 						}
 					}
+					headCommit: commits(last: 1) {
+						nodes {
+							commit {
+								oid
+								status {
+									contexts {
+										context
+										state
+										targetUrl
+										description
+										createdAt
+									}
+								}
+							}
+						}
+					}
+					reviewThreads(first: 10) {
+						totalCount
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+						nodes {
+							%s
+						}
+					}
+					files(first: 100) {
+						%s
+					}
+					rawBody: body
+					closingIssuesReferences(first: 10) {
+						nodes {
+							%s
+						}
+					}
 				}
 			}
 		}
-	}`, label, name, owner, cursorVal)
+	}`, label, name, owner, cursorVal, pullrequestTimelineItemTypes, pullrequestTimelineFields, fmt.Sprintf(reviewThreadFields, 10), pullrequestFileFields, issueReferenceFields)
 }
 
-var issuesQuery = `
-query getIssues($name: String!, $owner: String!, $before: String, $after: String) {
+var defaultBranchCommitsQuery = `
+query GetDefaultBranchCommits($name: String!, $owner: String!, $first: Int!, $after: String, $since: GitTimestamp) {
+	repository(name: $name, owner: $owner) {
+		defaultBranchRef {
+			name
+			target {
+				...on Commit {
+					history(first: $first, after: $after, since: $since) {
+						totalCount
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+						nodes {
+							sha: oid
+							message
+							authoredDate
+							additions
+							deletions
+							changedFiles
+							url
+							author {
+								avatarUrl
+								email
+								name
+								user {
+									id
+									login
+								}
+							}
+							committer {
+								avatarUrl
+								email
+								name
+								user {
+									id
+									login
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
 	rateLimit {
 		limit
 		cost
 		remaining
 		resetAt
 	}
+}
+`
+
+var branchNamesQuery = `
+query GetBranchNames($name: String!, $owner: String!, $first: Int!, $after: String) {
 	repository(name: $name, owner: $owner) {
-		issues(first: 100, before: $before, after: $after, orderBy: {field: UPDATED_AT, direction: DESC}, states: [OPEN, CLOSED]) {
-		totalCount
-		pageInfo {
-			hasNextPage
-			startCursor
-			endCursor
-		}
-		nodes {
-			__typename
-			id
-			createdAt
-			updatedAt
-			closedAt
-			state
-			url
-			title
-			body
-			closed
-			number
-			milestone {
-				id
-			}
-			labels(first: 20, orderBy: {field: CREATED_AT, direction: ASC}) {
-				nodes {
-					id
-					name
-					color
-					description
-				}
-			}
-			comments(last: 100) {
-				totalCount
-				pageInfo {
-					startCursor
-					endCursor
-					hasNextPage
-					hasPreviousPage
-				}
-				nodes {
-					id
-					url
-					body
-					createdAt
-					updatedAt
-					author {
-					 type: __typename
-					 avatarUrl
-					 login
-					 url
-					 ... on User {
-						id
-						email
-						name
-					 }
-					}
-				}
-			}
-			assignees(last: 1) {
-				nodes {
-				 id
-				 login
-				 avatarUrl
-				}
+		refs(refPrefix: "refs/heads/", first: $first, after: $after) {
+			pageInfo {
+				hasNextPage
+				endCursor
 			}
-			author {
-				type: __typename
-				avatarUrl
-				login
-				url
-				... on User {
-					id
-					email
-					name
-				}
+			nodes {
+				name
 			}
-		 }
 		}
 	}
- }
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
 `
 
-var projectIssuesQuery = `
-query getBoardIssues($name: String!, $owner: String!, $num: Int!) {
-	repository(name: $name, owner: $owner) {
-		project(number: $num) {
-			name
-			id
-			url
-			updatedAt
-			columns(first: 100) {
-				nodes {
-					id
-					name
-					purpose
+var releaseFields = `
+	id
+	name
+	tagName
+	description
+	url
+	isDraft
+	isPrerelease
+	createdAt
+	publishedAt
+	author {
+		type: __typename
+		id
+		login
+		email
+		name
+		avatarUrl
+		url
+	}
+	tagCommit {
+		oid
+	}
+`
+
+var tagFields = `
+	name
+	target {
+		type: __typename
+		oid
+		...on Commit {
+			committedDate
+		}
+		...on Tag {
+			tagger {
+				date
+			}
+			target {
+				oid
+				...on Commit {
+					committedDate
+				}
+			}
+		}
+	}
+`
+
+var releasesQuery = fmt.Sprintf(`
+query GetReleases($name: String!, $owner: String!, $first: Int!, $after: String) {
+	repository(name: $name, owner: $owner) {
+		releases(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: DESC}) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				%s
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`, releaseFields)
+
+var tagsQuery = fmt.Sprintf(`
+query GetTags($name: String!, $owner: String!, $first: Int!, $after: String) {
+	repository(name: $name, owner: $owner) {
+		refs(refPrefix: "refs/tags/", first: $first, after: $after, orderBy: {field: TAG_COMMIT_DATE, direction: DESC}) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				%s
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`, tagFields)
+
+var tagQuery = fmt.Sprintf(`
+query GetTag($name: String!, $owner: String!, $ref: String!, $tag: String!) {
+	repository(name: $name, owner: $owner) {
+		ref(qualifiedName: $ref) {
+			%s
+		}
+		release(tagName: $tag) {
+			%s
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`, tagFields, releaseFields)
+
+var deploymentFields = `
+	id
+	environment
+	description
+	state
+	createdAt
+	updatedAt
+	commitOid
+	ref {
+		name
+	}
+	creator {
+		type: __typename
+		avatarUrl
+		login
+		url
+		...on User {
+			id
+			email
+			name
+		}
+	}
+	statuses(first: 100) {
+		nodes {
+			state
+			description
+			createdAt
+			logUrl
+			environmentUrl
+			creator {
+				type: __typename
+				avatarUrl
+				login
+				url
+				...on User {
+					id
+					email
+					name
+				}
+			}
+		}
+	}
+`
+
+var deploymentsQuery = fmt.Sprintf(`
+query GetDeployments($name: String!, $owner: String!, $first: Int!, $after: String, $environments: [String!]) {
+	repository(name: $name, owner: $owner) {
+		deployments(first: $first, after: $after, environments: $environments, orderBy: {field: CREATED_AT, direction: DESC}) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				%s
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`, deploymentFields)
+
+var issuesQuery = fmt.Sprintf(`
+query getIssues($name: String!, $owner: String!, $first: Int!, $before: String, $after: String) {
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+	repository(name: $name, owner: $owner) {
+		issues(first: $first, before: $before, after: $after, orderBy: {field: UPDATED_AT, direction: DESC}, states: [OPEN, CLOSED]) {
+		totalCount
+		pageInfo {
+			hasNextPage
+			startCursor
+			endCursor
+		}
+		nodes {
+			__typename
+			id
+			createdAt
+			updatedAt
+			closedAt
+			state
+			url
+			title
+			body
+			closed
+			stateReason
+			number
+			milestone {
+				id
+			}
+			closedByPullRequestsReferences(first: 10, includeClosedPrs: true) {
+				nodes {
+					%s
+				}
+			}
+			timelineItems(first: 100, itemTypes: [%s]) {
+				%s
+			}
+			labels(first: 100, orderBy: {field: CREATED_AT, direction: ASC}) {
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+				nodes {
+					id
+					name
+					color
+					description
+				}
+			}
+			comments(last: 100) {
+				totalCount
+				pageInfo {
+					startCursor
+					endCursor
+					hasNextPage
+					hasPreviousPage
+				}
+				nodes {
+					id
+					url
+					body
+					createdAt
+					updatedAt
+					author {
+					 type: __typename
+					 avatarUrl
+					 login
+					 url
+					 ... on User {
+						id
+						email
+						name
+					 }
+					}
+				}
+			}
+			assignees(last: 1) {
+				nodes {
+				 id
+				 login
+				 avatarUrl
+				}
+			}
+			author {
+				type: __typename
+				avatarUrl
+				login
+				url
+				... on User {
+					id
+					email
+					name
+				}
+			}
+		 }
+		}
+	}
+ }
+`, issueReferenceFields, issueTimelineItemTypes, issueTimelineFields)
+
+var issueCommentsPagedQuery = `
+query GetIssueComments($name: String!, $owner: String!, $first: Int!, $before: String, $number: Int!) {
+	repository(name: $name, owner: $owner) {
+		issue(number: $number) {
+			comments(last: $first, before: $before) {
+				totalCount
+				pageInfo {
+					hasPreviousPage
+					startCursor
+					endCursor
+				}
+				nodes {
+					id
+					url
+					body
+					createdAt
+					updatedAt
+					author {
+						type: __typename
+						avatarUrl
+						login
+						url
+						... on User {
+							id
+							email
+							name
+						}
+					}
+				}
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`
+
+var projectIssuesQuery = `
+query getBoardIssues($name: String!, $owner: String!, $num: Int!) {
+	repository(name: $name, owner: $owner) {
+		project(number: $num) {
+			name
+			id
+			url
+			updatedAt
+			columns(first: 100) {
+				nodes {
+					id
+					name
+					purpose
 					cards(first: 100, archivedStates: NOT_ARCHIVED) {
 						nodes {
 						id
@@ -965,68 +1414,709 @@ query getBoardIssues($name: String!, $owner: String!, $num: Int!) {
 }
 `
 
-var repositoryMilestonesQuery = `
-query getMilestones($name: String!, $owner: String!, $before: String, $after: String) {
+var repositoryMilestonesQuery = `
+query getMilestones($name: String!, $owner: String!, $before: String, $after: String) {
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+	repository(name: $name, owner: $owner) {
+		milestones(first:100, before:$before, after:$after, orderBy:{field:UPDATED_AT, direction:DESC}) {
+			totalCount
+			pageInfo {
+				hasNextPage
+				startCursor
+				endCursor
+			}
+		 	nodes {
+				id
+				title
+				number
+				description
+				url
+				closed
+				createdAt
+				updatedAt
+				closedAt
+				dueOn
+				state
+				creator {
+					type: __typename
+					avatarUrl
+					login
+					url
+					... on User {
+						id
+						email
+						name
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+var pullrequestNodeIDQuery = `
+query getPRNodeID($name: String!, $owner: String!, $number: Int!) { 
+	repository(name: $name owner: $owner){
+		pullRequest(number:$number) {
+		 id
+		}
+	}
+}`
+
+var teamMembersQuery = `
+query GetTeamMembers($login: String!, $slug: String!, $first: Int!, $after: String, $membership: TeamMembershipType!) {
+	organization(login: $login) {
+		team(slug: $slug) {
+			members(first: $first, after: $after, membership: $membership) {
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+				nodes {
+					type: __typename
+					id
+					login
+					email
+					name
+					avatarUrl
+					url
+				}
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`
+
+var teamChildTeamsQuery = `
+query GetTeamChildTeams($login: String!, $slug: String!, $first: Int!, $after: String) {
+	organization(login: $login) {
+		team(slug: $slug) {
+			childTeams(first: $first, after: $after, immediateOnly: true) {
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+				nodes {
+					id
+				}
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`
+
+var teamFields = `
+	id
+	name
+	slug
+	description
+	url
+	updatedAt
+	parentTeam {
+		id
+	}
+	childTeams(first: 100, immediateOnly: true) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			id
+		}
+	}
+	members(first: 100, membership: IMMEDIATE) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			type: __typename
+			id
+			login
+			email
+			name
+			avatarUrl
+			url
+		}
+	}
+`
+
+var teamsQuery = fmt.Sprintf(`
+query GetTeams($login: String!, $first: Int!, $after: String) {
+	organization(login: $login) {
+		teams(first: $first, after: $after) {
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+			nodes {
+				%s
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`, teamFields)
+
+var teamQuery = fmt.Sprintf(`
+query GetTeam($login: String!, $slug: String!) {
+	organization(login: $login) {
+		team(slug: $slug) {
+			%s
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`, teamFields)
+
+type mutationResponse struct {
+	ID int `json:"clientMutationId"`
+}
+
+var pullRequestUpdateMutation = `
+mutation updatePullrequest($input: UpdatePullRequestInput!) {
+	updatePullRequest(input: $input) {
+		clientMutationId
+	}
+}
+`
+
+var reviewCommentFields = `
+	id
+	createdAt
+	updatedAt
+	url
+	bodyHTML
+	path
+	line
+	originalLine
+	diffHunk
+	outdated
+	replyTo {
+		id
+	}
+	pullRequestReview {
+		id
+	}
+	author {
+		type: __typename
+		avatarUrl
+		login
+		url
+		...on User {
+			id
+			email
+			name
+		}
+		...on Bot {
+			id
+		}
+	}
+`
+
+// reviewThreadFields needs the number of comments to fetch for each thread
+var reviewThreadFields = `
+	id
+	isResolved
+	isOutdated
+	comments(first: %d) {
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+		nodes {
+			` + reviewCommentFields + `
+		}
+	}
+`
+
+var pullrequestReviewThreadsPagedQuery = fmt.Sprintf(`
+query GetPullRequestReviewThreads($name: String!, $owner: String!, $first: Int!, $after: String, $number: Int!) {
+	repository(name: $name, owner: $owner) {
+		pullRequest(number: $number) {
+			reviewThreads(first: $first, after: $after) {
+				totalCount
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+				nodes {
+					%s
+				}
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`, fmt.Sprintf(reviewThreadFields, 100))
+
+var reviewThreadQuery = fmt.Sprintf(`
+query GetReviewThread($id: ID!) {
+	node(id: $id) {
+		...on PullRequestReviewThread {
+			%s
+		}
+	}
+}
+`, fmt.Sprintf(reviewThreadFields, 100))
+
+var reviewThreadCommentsQuery = fmt.Sprintf(`
+query GetReviewThreadComments($id: ID!, $first: Int!, $after: String) {
+	node(id: $id) {
+		...on PullRequestReviewThread {
+			comments(first: $first, after: $after) {
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+				nodes {
+					%s
+				}
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`, reviewCommentFields)
+
+var reviewCommentQuery = fmt.Sprintf(`
+query GetReviewComment($id: ID!) {
+	node(id: $id) {
+		...on PullRequestReviewComment {
+			%s
+			pullRequestReviewThread {
+				isResolved
+			}
+		}
+	}
+}
+`, reviewCommentFields)
+
+var pullrequestFileFields = `
+	totalCount
+	pageInfo {
+		hasNextPage
+		endCursor
+	}
+	nodes {
+		path
+		additions
+		deletions
+		changeType
+	}
+`
+
+var pullrequestFilesPagedQuery = fmt.Sprintf(`
+query GetPullRequestFiles($name: String!, $owner: String!, $first: Int!, $after: String, $number: Int!) {
+	repository(name: $name, owner: $owner) {
+		pullRequest(number: $number) {
+			files(first: $first, after: $after) {
+				%s
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`, pullrequestFileFields)
+
+var issueReferenceFields = `
+	id
+	number
+	url
+	repository {
+		id
+		nameWithOwner
+	}
+`
+
+var pullrequestClosingIssuesQuery = fmt.Sprintf(`
+query GetPullRequestClosingIssues($id: ID!) {
+	node(id: $id) {
+		...on PullRequest {
+			closingIssuesReferences(first: 10) {
+				nodes {
+					%s
+				}
+			}
+		}
+	}
+}
+`, issueReferenceFields)
+
+var issueClosedByQuery = fmt.Sprintf(`
+query GetIssueClosedBy($id: ID!) {
+	node(id: $id) {
+		...on Issue {
+			stateReason
+			closedByPullRequestsReferences(first: 10, includeClosedPrs: true) {
+				nodes {
+					%s
+				}
+			}
+		}
+	}
+}
+`, issueReferenceFields)
+
+// issueTimelineItemTypes are the timeline items which are changes to the issue
+var issueTimelineItemTypes = "CLOSED_EVENT, REOPENED_EVENT, LABELED_EVENT, UNLABELED_EVENT, ASSIGNED_EVENT, UNASSIGNED_EVENT, MILESTONED_EVENT, DEMILESTONED_EVENT, RENAMED_TITLE_EVENT, MOVED_COLUMNS_IN_PROJECT_EVENT"
+
+var timelineActorFields = `
+	type: __typename
+	avatarUrl
+	login
+	url
+	...on User {
+		id
+		email
+		name
+	}
+	...on Bot {
+		id
+	}
+`
+
+var issueTimelineFields = fmt.Sprintf(`
+	pageInfo {
+		hasNextPage
+		endCursor
+	}
+	nodes {
+		type: __typename
+		...on ClosedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+		}
+		...on ReopenedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+		}
+		...on LabeledEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+			label {
+				name
+			}
+		}
+		...on UnlabeledEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+			label {
+				name
+			}
+		}
+		...on AssignedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+			assignee {
+				...on User {
+					type: __typename
+					id
+					login
+					email
+					name
+					avatarUrl
+					url
+				}
+				...on Bot {
+					type: __typename
+					id
+					login
+					avatarUrl
+					url
+				}
+			}
+		}
+		...on UnassignedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+			assignee {
+				...on User {
+					type: __typename
+					id
+					login
+					email
+					name
+					avatarUrl
+					url
+				}
+				...on Bot {
+					type: __typename
+					id
+					login
+					avatarUrl
+					url
+				}
+			}
+		}
+		...on MilestonedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+			milestoneTitle
+		}
+		...on DemilestonedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+			milestoneTitle
+		}
+		...on RenamedTitleEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+			previousTitle
+			currentTitle
+		}
+		...on MovedColumnsInProjectEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+			previousProjectColumnName
+			projectColumnName
+		}
+	}
+`, timelineActorFields)
+
+var issueTimelinePagedQuery = fmt.Sprintf(`
+query GetIssueTimeline($id: ID!, $first: Int!, $after: String) {
+	node(id: $id) {
+		...on Issue {
+			timelineItems(first: $first, after: $after, itemTypes: [%s]) {
+				%s
+			}
+		}
+	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
+}
+`, issueTimelineItemTypes, issueTimelineFields)
+
+// pullrequestTimelineItemTypes are the timeline items in the lifecycle of a pull request
+var pullrequestTimelineItemTypes = "READY_FOR_REVIEW_EVENT, CONVERT_TO_DRAFT_EVENT, REVIEW_REQUESTED_EVENT, HEAD_REF_FORCE_PUSHED_EVENT, REOPENED_EVENT, CLOSED_EVENT, MERGED_EVENT, BASE_REF_CHANGED_EVENT"
+
+var pullrequestTimelineFields = fmt.Sprintf(`
+	pageInfo {
+		hasNextPage
+		endCursor
+	}
+	nodes {
+		type: __typename
+		...on ReadyForReviewEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+		}
+		...on ConvertToDraftEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+		}
+		...on ReviewRequestedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+		}
+		...on HeadRefForcePushedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+		}
+		...on ReopenedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+		}
+		...on ClosedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+		}
+		...on MergedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+		}
+		...on BaseRefChangedEvent {
+			id
+			createdAt
+			actor {
+				%[1]s
+			}
+		}
+	}
+`, timelineActorFields)
+
+var pullrequestTimelinePagedQuery = fmt.Sprintf(`
+query GetPullRequestTimeline($id: ID!, $first: Int!, $after: String) {
+	node(id: $id) {
+		...on PullRequest {
+			timelineItems(first: $first, after: $after, itemTypes: [%s]) {
+				%s
+			}
+		}
+	}
 	rateLimit {
 		limit
 		cost
 		remaining
 		resetAt
 	}
+}
+`, pullrequestTimelineItemTypes, pullrequestTimelineFields)
+
+var repoLabelsPagedQuery = `
+query GetRepoLabels($name: String!, $owner: String!, $first: Int!, $after: String) {
 	repository(name: $name, owner: $owner) {
-		milestones(first:100, before:$before, after:$after, orderBy:{field:UPDATED_AT, direction:DESC}) {
-			totalCount
+		labels(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: ASC}) {
 			pageInfo {
 				hasNextPage
-				startCursor
 				endCursor
 			}
-		 	nodes {
+			nodes {
 				id
-				title
-				number
+				name
+				color
 				description
-				url
-				closed
-				createdAt
-				updatedAt
-				closedAt
-				dueOn
-				state
-				creator {
-					type: __typename
-					avatarUrl
-					login
-					url
-					... on User {
-						id
-						email
-						name
-					}
-				}
 			}
 		}
 	}
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
 }
 `
 
-var pullrequestNodeIDQuery = `
-query getPRNodeID($name: String!, $owner: String!, $number: Int!) { 
-	repository(name: $name owner: $owner){
-		pullRequest(number:$number) {
-		 id
+var issueLabelsPagedQuery = `
+query GetIssueLabelsPaged($id: ID!, $first: Int!, $after: String) {
+	node(id: $id) {
+		...on Issue {
+			labels(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: ASC}) {
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+				nodes {
+					id
+					name
+					color
+					description
+				}
+			}
 		}
 	}
-}`
-
-type mutationResponse struct {
-	ID int `json:"clientMutationId"`
+	rateLimit {
+		limit
+		cost
+		remaining
+		resetAt
+	}
 }
+`
 
-var pullRequestUpdateMutation = `
-mutation updatePullrequest($input: UpdatePullRequestInput!) {
-	updatePullRequest(input: $input) {
-		clientMutationId
+var issueLabelsQuery = `
+query GetIssueLabels($id: ID!) {
+	node(id: $id) {
+		...on Issue {
+			labels(first: 100) {
+				nodes {
+					id
+					name
+				}
+			}
+			repository {
+				nameWithOwner
+			}
+		}
 	}
 }
 `
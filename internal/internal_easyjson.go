@@ -536,7 +536,7 @@ func (v *timelineItem) UnmarshalJSON(data []byte) error {
 func (v *timelineItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson2a877177DecodeGithubComPinptGithubInternal4(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal5(in *jlexer.Lexer, out *repositoryPullrequests) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal5(in *jlexer.Lexer, out *teamNode) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -555,10 +555,36 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal5(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "repository":
-			(out.Repository).UnmarshalEasyJSON(in)
-		case "rateLimit":
-			(out.RateLimit).UnmarshalEasyJSON(in)
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "slug":
+			out.Slug = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "parentTeam":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentTeam = nil
+			} else {
+				if out.ParentTeam == nil {
+					out.ParentTeam = new(struct {
+						ID string `json:"id"`
+					})
+				}
+				easyjson2a877177Decode2(in, out.ParentTeam)
+			}
+		case "childTeams":
+			easyjson2a877177Decode3(in, &out.ChildTeams)
+		case "members":
+			easyjson2a877177Decode4(in, &out.Members)
 		default:
 			in.SkipRecursive()
 		}
@@ -569,47 +595,89 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal5(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal5(out *jwriter.Writer, in repositoryPullrequests) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal5(out *jwriter.Writer, in teamNode) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"repository\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		(in.Repository).MarshalEasyJSON(out)
+		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"rateLimit\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		(in.RateLimit).MarshalEasyJSON(out)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"slug\":"
+		out.RawString(prefix)
+		out.String(string(in.Slug))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"parentTeam\":"
+		out.RawString(prefix)
+		if in.ParentTeam == nil {
+			out.RawString("null")
+		} else {
+			easyjson2a877177Encode2(out, *in.ParentTeam)
+		}
+	}
+	{
+		const prefix string = ",\"childTeams\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode3(out, in.ChildTeams)
+	}
+	{
+		const prefix string = ",\"members\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode4(out, in.Members)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v repositoryPullrequests) MarshalJSON() ([]byte, error) {
+func (v teamNode) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson2a877177EncodeGithubComPinptGithubInternal5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repositoryPullrequests) MarshalEasyJSON(w *jwriter.Writer) {
+func (v teamNode) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson2a877177EncodeGithubComPinptGithubInternal5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *repositoryPullrequests) UnmarshalJSON(data []byte) error {
+func (v *teamNode) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson2a877177DecodeGithubComPinptGithubInternal5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repositoryPullrequests) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *teamNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson2a877177DecodeGithubComPinptGithubInternal5(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal6(in *jlexer.Lexer, out *repositoryMilestonesResult) {
+func easyjson2a877177Decode4(in *jlexer.Lexer, out *struct {
+	PageInfo pageInfo `json:"pageInfo"`
+	Nodes    []author `json:"nodes"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -628,10 +696,31 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal6(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "rateLimit":
-			(out.RateLimit).UnmarshalEasyJSON(in)
-		case "repository":
-			easyjson2a877177Decode2(in, &out.Repository)
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]author, 0, 1)
+					} else {
+						out.Nodes = []author{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 author
+					(v4).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -642,49 +731,39 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal6(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal6(out *jwriter.Writer, in repositoryMilestonesResult) {
+func easyjson2a877177Encode4(out *jwriter.Writer, in struct {
+	PageInfo pageInfo `json:"pageInfo"`
+	Nodes    []author `json:"nodes"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"rateLimit\":"
+		const prefix string = ",\"pageInfo\":"
 		out.RawString(prefix[1:])
-		(in.RateLimit).MarshalEasyJSON(out)
+		(in.PageInfo).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"repository\":"
+		const prefix string = ",\"nodes\":"
 		out.RawString(prefix)
-		easyjson2a877177Encode2(out, in.Repository)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Nodes {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v repositoryMilestonesResult) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal6(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repositoryMilestonesResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal6(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *repositoryMilestonesResult) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal6(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repositoryMilestonesResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal6(l, v)
-}
-func easyjson2a877177Decode2(in *jlexer.Lexer, out *struct {
-	TotalCount int            `json:"totalCount"`
-	Milestones milestoneNodes `json:"milestones"`
+func easyjson2a877177Decode3(in *jlexer.Lexer, out *struct {
+	PageInfo pageInfo    `json:"pageInfo"`
+	Nodes    []childTeam `json:"nodes"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -704,10 +783,31 @@ func easyjson2a877177Decode2(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "totalCount":
-			out.TotalCount = int(in.Int())
-		case "milestones":
-			(out.Milestones).UnmarshalEasyJSON(in)
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]childTeam, 0, 4)
+					} else {
+						out.Nodes = []childTeam{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 childTeam
+					(v7).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -718,26 +818,39 @@ func easyjson2a877177Decode2(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjson2a877177Encode2(out *jwriter.Writer, in struct {
-	TotalCount int            `json:"totalCount"`
-	Milestones milestoneNodes `json:"milestones"`
+func easyjson2a877177Encode3(out *jwriter.Writer, in struct {
+	PageInfo pageInfo    `json:"pageInfo"`
+	Nodes    []childTeam `json:"nodes"`
 }) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"totalCount\":"
+		const prefix string = ",\"pageInfo\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.TotalCount))
+		(in.PageInfo).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"milestones\":"
+		const prefix string = ",\"nodes\":"
 		out.RawString(prefix)
-		(in.Milestones).MarshalEasyJSON(out)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Nodes {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal7(in *jlexer.Lexer, out *repository) {
+func easyjson2a877177Decode2(in *jlexer.Lexer, out *struct {
+	ID string `json:"id"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -758,34 +871,6 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal7(in *jlexer.Lexer, out *
 		switch key {
 		case "id":
 			out.ID = string(in.String())
-		case "nameWithOwner":
-			out.Name = string(in.String())
-		case "url":
-			out.URL = string(in.String())
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "description":
-			out.Description = string(in.String())
-		case "primaryLanguage":
-			(out.Language).UnmarshalEasyJSON(in)
-		case "defaultBranchRef":
-			(out.DefaultBranch).UnmarshalEasyJSON(in)
-		case "isArchived":
-			out.IsArchived = bool(in.Bool())
-		case "isFork":
-			out.IsFork = bool(in.Bool())
-		case "hasProjectsEnabled":
-			out.HasProjects = bool(in.Bool())
-		case "hasIssuesEnabled":
-			out.HasIssues = bool(in.Bool())
-		case "labels":
-			(out.Labels).UnmarshalEasyJSON(in)
-		case "pullRequests":
-			(out.Pullrequests).UnmarshalEasyJSON(in)
-		case "owner":
-			easyjson2a877177Decode3(in, &out.Owner)
 		default:
 			in.SkipRecursive()
 		}
@@ -796,7 +881,9 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal7(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal7(out *jwriter.Writer, in repository) {
+func easyjson2a877177Encode2(out *jwriter.Writer, in struct {
+	ID string `json:"id"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -805,222 +892,86 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal7(out *jwriter.Writer, in
 		out.RawString(prefix[1:])
 		out.String(string(in.ID))
 	}
-	{
-		const prefix string = ",\"nameWithOwner\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
+	out.RawByte('}')
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal6(in *jlexer.Lexer, out *tagsResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"url\":"
-		out.RawString(prefix)
-		out.String(string(in.URL))
-	}
-	{
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"primaryLanguage\":"
-		out.RawString(prefix)
-		(in.Language).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"defaultBranchRef\":"
-		out.RawString(prefix)
-		(in.DefaultBranch).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"isArchived\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsArchived))
-	}
-	{
-		const prefix string = ",\"isFork\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsFork))
-	}
-	{
-		const prefix string = ",\"hasProjectsEnabled\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.HasProjects))
-	}
-	{
-		const prefix string = ",\"hasIssuesEnabled\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.HasIssues))
-	}
-	{
-		const prefix string = ",\"labels\":"
-		out.RawString(prefix)
-		(in.Labels).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"pullRequests\":"
-		out.RawString(prefix)
-		(in.Pullrequests).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"owner\":"
-		out.RawString(prefix)
-		easyjson2a877177Encode3(out, in.Owner)
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v repository) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal7(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repository) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal7(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *repository) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal7(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repository) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal7(l, v)
-}
-func easyjson2a877177Decode3(in *jlexer.Lexer, out *struct {
-	Login string `json:"login"`
-}) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "login":
-			out.Login = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson2a877177Encode3(out *jwriter.Writer, in struct {
-	Login string `json:"login"`
-}) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"login\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Login))
-	}
-	out.RawByte('}')
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal8(in *jlexer.Lexer, out *repoWithNameResult) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "data":
-			easyjson2a877177Decode4(in, &out.Data)
-		case "rateLimit":
-			(out.RateLimit).UnmarshalEasyJSON(in)
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rateLimit":
+			(out.RateLimit).UnmarshalEasyJSON(in)
+		case "repository":
+			easyjson2a877177Decode5(in, &out.Repository)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
 	in.Delim('}')
 	if isTopLevel {
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal8(out *jwriter.Writer, in repoWithNameResult) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal6(out *jwriter.Writer, in tagsResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"data\":"
+		const prefix string = ",\"rateLimit\":"
 		out.RawString(prefix[1:])
-		easyjson2a877177Encode4(out, in.Data)
+		(in.RateLimit).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"rateLimit\":"
+		const prefix string = ",\"repository\":"
 		out.RawString(prefix)
-		(in.RateLimit).MarshalEasyJSON(out)
+		easyjson2a877177Encode5(out, in.Repository)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v repoWithNameResult) MarshalJSON() ([]byte, error) {
+func (v tagsResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal8(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repoWithNameResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal8(w, v)
+func (v tagsResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *repoWithNameResult) UnmarshalJSON(data []byte) error {
+func (v *tagsResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal8(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repoWithNameResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal8(l, v)
+func (v *tagsResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal6(l, v)
 }
-func easyjson2a877177Decode4(in *jlexer.Lexer, out *struct {
-	Repositories struct {
-		TotalCount int        `json:"totalCount"`
-		PageInfo   pageInfo   `json:"pageInfo"`
-		Nodes      []repoName `json:"nodes"`
-	} `json:"repositories"`
+func easyjson2a877177Decode5(in *jlexer.Lexer, out *struct {
+	Refs struct {
+		PageInfo pageInfo `json:"pageInfo"`
+		Nodes    []tag    `json:"nodes"`
+	} `json:"refs"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -1040,8 +991,8 @@ func easyjson2a877177Decode4(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "repositories":
-			easyjson2a877177Decode5(in, &out.Repositories)
+		case "refs":
+			easyjson2a877177Decode6(in, &out.Refs)
 		default:
 			in.SkipRecursive()
 		}
@@ -1052,27 +1003,25 @@ func easyjson2a877177Decode4(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjson2a877177Encode4(out *jwriter.Writer, in struct {
-	Repositories struct {
-		TotalCount int        `json:"totalCount"`
-		PageInfo   pageInfo   `json:"pageInfo"`
-		Nodes      []repoName `json:"nodes"`
-	} `json:"repositories"`
+func easyjson2a877177Encode5(out *jwriter.Writer, in struct {
+	Refs struct {
+		PageInfo pageInfo `json:"pageInfo"`
+		Nodes    []tag    `json:"nodes"`
+	} `json:"refs"`
 }) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"repositories\":"
+		const prefix string = ",\"refs\":"
 		out.RawString(prefix[1:])
-		easyjson2a877177Encode5(out, in.Repositories)
+		easyjson2a877177Encode6(out, in.Refs)
 	}
 	out.RawByte('}')
 }
-func easyjson2a877177Decode5(in *jlexer.Lexer, out *struct {
-	TotalCount int        `json:"totalCount"`
-	PageInfo   pageInfo   `json:"pageInfo"`
-	Nodes      []repoName `json:"nodes"`
+func easyjson2a877177Decode6(in *jlexer.Lexer, out *struct {
+	PageInfo pageInfo `json:"pageInfo"`
+	Nodes    []tag    `json:"nodes"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -1092,8 +1041,6 @@ func easyjson2a877177Decode5(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "totalCount":
-			out.TotalCount = int(in.Int())
 		case "pageInfo":
 			(out.PageInfo).UnmarshalEasyJSON(in)
 		case "nodes":
@@ -1104,17 +1051,17 @@ func easyjson2a877177Decode5(in *jlexer.Lexer, out *struct {
 				in.Delim('[')
 				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.Nodes = make([]repoName, 0, 1)
+						out.Nodes = make([]tag, 0, 1)
 					} else {
-						out.Nodes = []repoName{}
+						out.Nodes = []tag{}
 					}
 				} else {
 					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 repoName
-					(v4).UnmarshalEasyJSON(in)
-					out.Nodes = append(out.Nodes, v4)
+					var v10 tag
+					(v10).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v10)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1129,22 +1076,16 @@ func easyjson2a877177Decode5(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjson2a877177Encode5(out *jwriter.Writer, in struct {
-	TotalCount int        `json:"totalCount"`
-	PageInfo   pageInfo   `json:"pageInfo"`
-	Nodes      []repoName `json:"nodes"`
+func easyjson2a877177Encode6(out *jwriter.Writer, in struct {
+	PageInfo pageInfo `json:"pageInfo"`
+	Nodes    []tag    `json:"nodes"`
 }) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"totalCount\":"
-		out.RawString(prefix[1:])
-		out.Int(int(in.TotalCount))
-	}
 	{
 		const prefix string = ",\"pageInfo\":"
-		out.RawString(prefix)
+		out.RawString(prefix[1:])
 		(in.PageInfo).MarshalEasyJSON(out)
 	}
 	{
@@ -1154,18 +1095,18 @@ func easyjson2a877177Encode5(out *jwriter.Writer, in struct {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.Nodes {
-				if v5 > 0 {
+			for v11, v12 := range in.Nodes {
+				if v11 > 0 {
 					out.RawByte(',')
 				}
-				(v6).MarshalEasyJSON(out)
+				(v12).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal9(in *jlexer.Lexer, out *repoProjectsResult) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal7(in *jlexer.Lexer, out *tag) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1184,8 +1125,10 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal9(in *jlexer.Lexer, out *
 			continue
 		}
 		switch key {
-		case "repository":
-			easyjson2a877177Decode6(in, &out.Repository)
+		case "name":
+			out.Name = string(in.String())
+		case "target":
+			easyjson2a877177Decode7(in, &out.Target)
 		default:
 			in.SkipRecursive()
 		}
@@ -1196,43 +1139,57 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal9(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal9(out *jwriter.Writer, in repoProjectsResult) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal7(out *jwriter.Writer, in tag) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"repository\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		easyjson2a877177Encode6(out, in.Repository)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"target\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode7(out, in.Target)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v repoProjectsResult) MarshalJSON() ([]byte, error) {
+func (v tag) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal9(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repoProjectsResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal9(w, v)
+func (v tag) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *repoProjectsResult) UnmarshalJSON(data []byte) error {
+func (v *tag) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal9(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repoProjectsResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal9(l, v)
+func (v *tag) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal7(l, v)
 }
-func easyjson2a877177Decode6(in *jlexer.Lexer, out *struct {
-	Projects repoProjectNode `json:"projects"`
+func easyjson2a877177Decode7(in *jlexer.Lexer, out *struct {
+	Type          string    `json:"type"`
+	Oid           string    `json:"oid"`
+	CommittedDate time.Time `json:"committedDate"`
+	Tagger        *struct {
+		Date time.Time `json:"date"`
+	} `json:"tagger"`
+	Target *struct {
+		Oid           string    `json:"oid"`
+		CommittedDate time.Time `json:"committedDate"`
+	} `json:"target"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -1252,32 +1209,103 @@ func easyjson2a877177Decode6(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "projects":
-			(out.Projects).UnmarshalEasyJSON(in)
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson2a877177Encode6(out *jwriter.Writer, in struct {
-	Projects repoProjectNode `json:"projects"`
-}) {
-	out.RawByte('{')
-	first := true
+		case "type":
+			out.Type = string(in.String())
+		case "oid":
+			out.Oid = string(in.String())
+		case "committedDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CommittedDate).UnmarshalJSON(data))
+			}
+		case "tagger":
+			if in.IsNull() {
+				in.Skip()
+				out.Tagger = nil
+			} else {
+				if out.Tagger == nil {
+					out.Tagger = new(struct {
+						Date time.Time `json:"date"`
+					})
+				}
+				easyjson2a877177Decode8(in, out.Tagger)
+			}
+		case "target":
+			if in.IsNull() {
+				in.Skip()
+				out.Target = nil
+			} else {
+				if out.Target == nil {
+					out.Target = new(struct {
+						Oid           string    `json:"oid"`
+						CommittedDate time.Time `json:"committedDate"`
+					})
+				}
+				easyjson2a877177Decode9(in, out.Target)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177Encode7(out *jwriter.Writer, in struct {
+	Type          string    `json:"type"`
+	Oid           string    `json:"oid"`
+	CommittedDate time.Time `json:"committedDate"`
+	Tagger        *struct {
+		Date time.Time `json:"date"`
+	} `json:"tagger"`
+	Target *struct {
+		Oid           string    `json:"oid"`
+		CommittedDate time.Time `json:"committedDate"`
+	} `json:"target"`
+}) {
+	out.RawByte('{')
+	first := true
 	_ = first
 	{
-		const prefix string = ",\"projects\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		(in.Projects).MarshalEasyJSON(out)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"oid\":"
+		out.RawString(prefix)
+		out.String(string(in.Oid))
+	}
+	{
+		const prefix string = ",\"committedDate\":"
+		out.RawString(prefix)
+		out.Raw((in.CommittedDate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"tagger\":"
+		out.RawString(prefix)
+		if in.Tagger == nil {
+			out.RawString("null")
+		} else {
+			easyjson2a877177Encode8(out, *in.Tagger)
+		}
+	}
+	{
+		const prefix string = ",\"target\":"
+		out.RawString(prefix)
+		if in.Target == nil {
+			out.RawString("null")
+		} else {
+			easyjson2a877177Encode9(out, *in.Target)
+		}
 	}
 	out.RawByte('}')
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal10(in *jlexer.Lexer, out *repoProjectResult) {
+func easyjson2a877177Decode9(in *jlexer.Lexer, out *struct {
+	Oid           string    `json:"oid"`
+	CommittedDate time.Time `json:"committedDate"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1296,8 +1324,12 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal10(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "repository":
-			easyjson2a877177Decode7(in, &out.Repository)
+		case "oid":
+			out.Oid = string(in.String())
+		case "committedDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CommittedDate).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1308,43 +1340,27 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal10(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal10(out *jwriter.Writer, in repoProjectResult) {
+func easyjson2a877177Encode9(out *jwriter.Writer, in struct {
+	Oid           string    `json:"oid"`
+	CommittedDate time.Time `json:"committedDate"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"repository\":"
+		const prefix string = ",\"oid\":"
 		out.RawString(prefix[1:])
-		easyjson2a877177Encode7(out, in.Repository)
+		out.String(string(in.Oid))
+	}
+	{
+		const prefix string = ",\"committedDate\":"
+		out.RawString(prefix)
+		out.Raw((in.CommittedDate).MarshalJSON())
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v repoProjectResult) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal10(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repoProjectResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal10(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *repoProjectResult) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal10(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repoProjectResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal10(l, v)
-}
-func easyjson2a877177Decode7(in *jlexer.Lexer, out *struct {
-	Project repoProject `json:"project"`
+func easyjson2a877177Decode8(in *jlexer.Lexer, out *struct {
+	Date time.Time `json:"date"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -1364,8 +1380,10 @@ func easyjson2a877177Decode7(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "project":
-			(out.Project).UnmarshalEasyJSON(in)
+		case "date":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1376,20 +1394,20 @@ func easyjson2a877177Decode7(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjson2a877177Encode7(out *jwriter.Writer, in struct {
-	Project repoProject `json:"project"`
+func easyjson2a877177Encode8(out *jwriter.Writer, in struct {
+	Date time.Time `json:"date"`
 }) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"project\":"
+		const prefix string = ",\"date\":"
 		out.RawString(prefix[1:])
-		(in.Project).MarshalEasyJSON(out)
+		out.Raw((in.Date).MarshalJSON())
 	}
 	out.RawByte('}')
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal11(in *jlexer.Lexer, out *repoProjectNode) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal8(in *jlexer.Lexer, out *requestedReviewer) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1408,29 +1426,24 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal11(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "nodes":
-			if in.IsNull() {
-				in.Skip()
-				out.Nodes = nil
-			} else {
-				in.Delim('[')
-				if out.Nodes == nil {
-					if !in.IsDelim(']') {
-						out.Nodes = make([]repoProject, 0, 1)
-					} else {
-						out.Nodes = []repoProject{}
-					}
-				} else {
-					out.Nodes = (out.Nodes)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v7 repoProject
-					(v7).UnmarshalEasyJSON(in)
-					out.Nodes = append(out.Nodes, v7)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "slug":
+			out.Slug = string(in.String())
+		case "organization":
+			easyjson2a877177Decode10(in, &out.Organization)
+		case "id":
+			out.ID = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "avatarUrl":
+			out.Avatar = string(in.String())
+		case "login":
+			out.Login = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "type":
+			out.Type = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1441,53 +1454,84 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal11(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal11(out *jwriter.Writer, in repoProjectNode) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal8(out *jwriter.Writer, in requestedReviewer) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"nodes\":"
+		const prefix string = ",\"slug\":"
 		out.RawString(prefix[1:])
-		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Nodes {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				(v9).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Slug))
+	}
+	{
+		const prefix string = ",\"organization\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode10(out, in.Organization)
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"avatarUrl\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix)
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v repoProjectNode) MarshalJSON() ([]byte, error) {
+func (v requestedReviewer) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal11(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repoProjectNode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal11(w, v)
+func (v requestedReviewer) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *repoProjectNode) UnmarshalJSON(data []byte) error {
+func (v *requestedReviewer) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal11(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repoProjectNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal11(l, v)
+func (v *requestedReviewer) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal8(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal12(in *jlexer.Lexer, out *repoProjectColumnNodes) {
+func easyjson2a877177Decode10(in *jlexer.Lexer, out *struct {
+	Login string `json:"login"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1506,29 +1550,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal12(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "nodes":
-			if in.IsNull() {
-				in.Skip()
-				out.Nodes = nil
-			} else {
-				in.Delim('[')
-				if out.Nodes == nil {
-					if !in.IsDelim(']') {
-						out.Nodes = make([]repoProjectColumn, 0, 1)
-					} else {
-						out.Nodes = []repoProjectColumn{}
-					}
-				} else {
-					out.Nodes = (out.Nodes)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v10 repoProjectColumn
-					(v10).UnmarshalEasyJSON(in)
-					out.Nodes = append(out.Nodes, v10)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "login":
+			out.Login = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1539,53 +1562,20 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal12(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal12(out *jwriter.Writer, in repoProjectColumnNodes) {
+func easyjson2a877177Encode10(out *jwriter.Writer, in struct {
+	Login string `json:"login"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"nodes\":"
+		const prefix string = ",\"login\":"
 		out.RawString(prefix[1:])
-		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v11, v12 := range in.Nodes {
-				if v11 > 0 {
-					out.RawByte(',')
-				}
-				(v12).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Login))
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v repoProjectColumnNodes) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal12(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repoProjectColumnNodes) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal12(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *repoProjectColumnNodes) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal12(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repoProjectColumnNodes) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal12(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal13(in *jlexer.Lexer, out *repoProjectColumn) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal9(in *jlexer.Lexer, out *repositoryPullrequests) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1604,14 +1594,10 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal13(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "purpose":
-			out.Purpose = string(in.String())
-		case "cards":
-			(out.Cards).UnmarshalEasyJSON(in)
+		case "repository":
+			(out.Repository).UnmarshalEasyJSON(in)
+		case "rateLimit":
+			(out.RateLimit).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -1622,57 +1608,47 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal13(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal13(out *jwriter.Writer, in repoProjectColumn) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal9(out *jwriter.Writer, in repositoryPullrequests) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"repository\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"purpose\":"
-		out.RawString(prefix)
-		out.String(string(in.Purpose))
+		(in.Repository).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"cards\":"
+		const prefix string = ",\"rateLimit\":"
 		out.RawString(prefix)
-		(in.Cards).MarshalEasyJSON(out)
+		(in.RateLimit).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v repoProjectColumn) MarshalJSON() ([]byte, error) {
+func (v repositoryPullrequests) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal13(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repoProjectColumn) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal13(w, v)
+func (v repositoryPullrequests) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *repoProjectColumn) UnmarshalJSON(data []byte) error {
+func (v *repositoryPullrequests) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal13(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repoProjectColumn) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal13(l, v)
+func (v *repositoryPullrequests) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal9(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal14(in *jlexer.Lexer, out *repoProjectCardNodes) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal10(in *jlexer.Lexer, out *repositoryMilestonesResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1691,29 +1667,10 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal14(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "nodes":
-			if in.IsNull() {
-				in.Skip()
-				out.Nodes = nil
-			} else {
-				in.Delim('[')
-				if out.Nodes == nil {
-					if !in.IsDelim(']') {
-						out.Nodes = make([]repoProjectCard, 0, 1)
-					} else {
-						out.Nodes = []repoProjectCard{}
-					}
-				} else {
-					out.Nodes = (out.Nodes)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v13 repoProjectCard
-					(v13).UnmarshalEasyJSON(in)
-					out.Nodes = append(out.Nodes, v13)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "rateLimit":
+			(out.RateLimit).UnmarshalEasyJSON(in)
+		case "repository":
+			easyjson2a877177Decode11(in, &out.Repository)
 		default:
 			in.SkipRecursive()
 		}
@@ -1724,53 +1681,50 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal14(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal14(out *jwriter.Writer, in repoProjectCardNodes) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal10(out *jwriter.Writer, in repositoryMilestonesResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"nodes\":"
+		const prefix string = ",\"rateLimit\":"
 		out.RawString(prefix[1:])
-		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v14, v15 := range in.Nodes {
-				if v14 > 0 {
-					out.RawByte(',')
-				}
-				(v15).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		(in.RateLimit).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"repository\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode11(out, in.Repository)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v repoProjectCardNodes) MarshalJSON() ([]byte, error) {
+func (v repositoryMilestonesResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal14(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repoProjectCardNodes) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal14(w, v)
+func (v repositoryMilestonesResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *repoProjectCardNodes) UnmarshalJSON(data []byte) error {
+func (v *repositoryMilestonesResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal14(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repoProjectCardNodes) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal14(l, v)
+func (v *repositoryMilestonesResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal10(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal15(in *jlexer.Lexer, out *repoProjectCardContent) {
+func easyjson2a877177Decode11(in *jlexer.Lexer, out *struct {
+	TotalCount int            `json:"totalCount"`
+	Milestones milestoneNodes `json:"milestones"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1789,10 +1743,10 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal15(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "__typename":
-			out.Type = string(in.String())
-		case "id":
-			out.ID = string(in.String())
+		case "totalCount":
+			out.TotalCount = int(in.Int())
+		case "milestones":
+			(out.Milestones).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -1803,47 +1757,26 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal15(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal15(out *jwriter.Writer, in repoProjectCardContent) {
+func easyjson2a877177Encode11(out *jwriter.Writer, in struct {
+	TotalCount int            `json:"totalCount"`
+	Milestones milestoneNodes `json:"milestones"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"__typename\":"
+		const prefix string = ",\"totalCount\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
+		out.Int(int(in.TotalCount))
 	}
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"milestones\":"
 		out.RawString(prefix)
-		out.String(string(in.ID))
+		(in.Milestones).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v repoProjectCardContent) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal15(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repoProjectCardContent) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal15(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *repoProjectCardContent) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal15(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repoProjectCardContent) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal15(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal16(in *jlexer.Lexer, out *repoProjectCard) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal11(in *jlexer.Lexer, out *repository) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1864,20 +1797,34 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal16(in *jlexer.Lexer, out
 		switch key {
 		case "id":
 			out.ID = string(in.String())
-		case "state":
-			out.State = string(in.String())
-		case "note":
-			out.Note = string(in.String())
-		case "content":
-			if in.IsNull() {
-				in.Skip()
-				out.Content = nil
-			} else {
-				if out.Content == nil {
-					out.Content = new(repoProjectCardContent)
-				}
-				(*out.Content).UnmarshalEasyJSON(in)
+		case "nameWithOwner":
+			out.Name = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
 			}
+		case "description":
+			out.Description = string(in.String())
+		case "primaryLanguage":
+			(out.Language).UnmarshalEasyJSON(in)
+		case "defaultBranchRef":
+			(out.DefaultBranch).UnmarshalEasyJSON(in)
+		case "isArchived":
+			out.IsArchived = bool(in.Bool())
+		case "isFork":
+			out.IsFork = bool(in.Bool())
+		case "hasProjectsEnabled":
+			out.HasProjects = bool(in.Bool())
+		case "hasIssuesEnabled":
+			out.HasIssues = bool(in.Bool())
+		case "labels":
+			(out.Labels).UnmarshalEasyJSON(in)
+		case "pullRequests":
+			(out.Pullrequests).UnmarshalEasyJSON(in)
+		case "owner":
+			easyjson2a877177Decode10(in, &out.Owner)
 		default:
 			in.SkipRecursive()
 		}
@@ -1888,7 +1835,7 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal16(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal16(out *jwriter.Writer, in repoProjectCard) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal11(out *jwriter.Writer, in repository) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1898,147 +1845,97 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal16(out *jwriter.Writer, i
 		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"state\":"
+		const prefix string = ",\"nameWithOwner\":"
 		out.RawString(prefix)
-		out.String(string(in.State))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"note\":"
+		const prefix string = ",\"url\":"
 		out.RawString(prefix)
-		out.String(string(in.Note))
+		out.String(string(in.URL))
 	}
 	{
-		const prefix string = ",\"content\":"
+		const prefix string = ",\"updatedAt\":"
 		out.RawString(prefix)
-		if in.Content == nil {
-			out.RawString("null")
-		} else {
-			(*in.Content).MarshalEasyJSON(out)
-		}
+		out.Raw((in.UpdatedAt).MarshalJSON())
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v repoProjectCard) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal16(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repoProjectCard) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal16(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *repoProjectCard) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal16(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repoProjectCard) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal16(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal17(in *jlexer.Lexer, out *repoProject) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "id":
-			out.ID = string(in.String())
-		case "url":
-			out.URL = string(in.String())
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "columns":
-			(out.Columns).UnmarshalEasyJSON(in)
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
+	{
+		const prefix string = ",\"primaryLanguage\":"
+		out.RawString(prefix)
+		(in.Language).MarshalEasyJSON(out)
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	{
+		const prefix string = ",\"defaultBranchRef\":"
+		out.RawString(prefix)
+		(in.DefaultBranch).MarshalEasyJSON(out)
 	}
-}
-func easyjson2a877177EncodeGithubComPinptGithubInternal17(out *jwriter.Writer, in repoProject) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		const prefix string = ",\"isArchived\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsArchived))
 	}
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"isFork\":"
 		out.RawString(prefix)
-		out.String(string(in.ID))
+		out.Bool(bool(in.IsFork))
 	}
 	{
-		const prefix string = ",\"url\":"
+		const prefix string = ",\"hasProjectsEnabled\":"
 		out.RawString(prefix)
-		out.String(string(in.URL))
+		out.Bool(bool(in.HasProjects))
 	}
 	{
-		const prefix string = ",\"updatedAt\":"
+		const prefix string = ",\"hasIssuesEnabled\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		out.Bool(bool(in.HasIssues))
 	}
 	{
-		const prefix string = ",\"columns\":"
+		const prefix string = ",\"labels\":"
 		out.RawString(prefix)
-		(in.Columns).MarshalEasyJSON(out)
+		(in.Labels).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"pullRequests\":"
+		out.RawString(prefix)
+		(in.Pullrequests).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"owner\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode10(out, in.Owner)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v repoProject) MarshalJSON() ([]byte, error) {
+func (v repository) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal17(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repoProject) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal17(w, v)
+func (v repository) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *repoProject) UnmarshalJSON(data []byte) error {
+func (v *repository) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal17(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repoProject) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal17(l, v)
+func (v *repository) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal11(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal18(in *jlexer.Lexer, out *repoName) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal12(in *jlexer.Lexer, out *repoWithNameResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2057,20 +1954,10 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal18(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "name":
-			out.RepoName = string(in.String())
-		case "nameWithOwner":
-			out.Name = string(in.String())
-		case "isPrivate":
-			out.IsPrivate = bool(in.Bool())
-		case "isArchived":
-			out.IsArchived = bool(in.Bool())
-		case "hasProjectsEnabled":
-			out.HasProjectsEnabled = bool(in.Bool())
-		case "hasIssuesEnabled":
-			out.HasIssuesEnabled = bool(in.Bool())
+		case "data":
+			easyjson2a877177Decode12(in, &out.Data)
+		case "rateLimit":
+			(out.RateLimit).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -2081,72 +1968,53 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal18(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal18(out *jwriter.Writer, in repoName) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal12(out *jwriter.Writer, in repoWithNameResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"data\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.RepoName))
-	}
-	{
-		const prefix string = ",\"nameWithOwner\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"isPrivate\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsPrivate))
-	}
-	{
-		const prefix string = ",\"isArchived\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsArchived))
-	}
-	{
-		const prefix string = ",\"hasProjectsEnabled\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.HasProjectsEnabled))
+		easyjson2a877177Encode12(out, in.Data)
 	}
 	{
-		const prefix string = ",\"hasIssuesEnabled\":"
+		const prefix string = ",\"rateLimit\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.HasIssuesEnabled))
+		(in.RateLimit).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v repoName) MarshalJSON() ([]byte, error) {
+func (v repoWithNameResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal18(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v repoName) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal18(w, v)
+func (v repoWithNameResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *repoName) UnmarshalJSON(data []byte) error {
+func (v *repoWithNameResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal18(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *repoName) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal18(l, v)
+func (v *repoWithNameResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal12(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal19(in *jlexer.Lexer, out *rateLimit) {
+func easyjson2a877177Decode12(in *jlexer.Lexer, out *struct {
+	Repositories struct {
+		TotalCount int        `json:"totalCount"`
+		PageInfo   pageInfo   `json:"pageInfo"`
+		Nodes      []repoName `json:"nodes"`
+	} `json:"repositories"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2165,16 +2033,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal19(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "limit":
-			out.Limit = int(in.Int())
-		case "cost":
-			out.Cost = int(in.Int())
-		case "remaining":
-			out.Remaining = int(in.Int())
-		case "resetAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ResetAt).UnmarshalJSON(data))
-			}
+		case "repositories":
+			easyjson2a877177Decode13(in, &out.Repositories)
 		default:
 			in.SkipRecursive()
 		}
@@ -2185,57 +2045,28 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal19(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal19(out *jwriter.Writer, in rateLimit) {
+func easyjson2a877177Encode12(out *jwriter.Writer, in struct {
+	Repositories struct {
+		TotalCount int        `json:"totalCount"`
+		PageInfo   pageInfo   `json:"pageInfo"`
+		Nodes      []repoName `json:"nodes"`
+	} `json:"repositories"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"limit\":"
+		const prefix string = ",\"repositories\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Limit))
-	}
-	{
-		const prefix string = ",\"cost\":"
-		out.RawString(prefix)
-		out.Int(int(in.Cost))
-	}
-	{
-		const prefix string = ",\"remaining\":"
-		out.RawString(prefix)
-		out.Int(int(in.Remaining))
-	}
-	{
-		const prefix string = ",\"resetAt\":"
-		out.RawString(prefix)
-		out.Raw((in.ResetAt).MarshalJSON())
+		easyjson2a877177Encode13(out, in.Repositories)
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v rateLimit) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal19(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v rateLimit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal19(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *rateLimit) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal19(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *rateLimit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal19(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal20(in *jlexer.Lexer, out *pullrequests) {
+func easyjson2a877177Decode13(in *jlexer.Lexer, out *struct {
+	TotalCount int        `json:"totalCount"`
+	PageInfo   pageInfo   `json:"pageInfo"`
+	Nodes      []repoName `json:"nodes"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2258,25 +2089,25 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal20(in *jlexer.Lexer, out
 			out.TotalCount = int(in.Int())
 		case "pageInfo":
 			(out.PageInfo).UnmarshalEasyJSON(in)
-		case "edges":
+		case "nodes":
 			if in.IsNull() {
 				in.Skip()
-				out.Edges = nil
+				out.Nodes = nil
 			} else {
 				in.Delim('[')
-				if out.Edges == nil {
+				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.Edges = make([]pullrequestNode, 0, 1)
+						out.Nodes = make([]repoName, 0, 1)
 					} else {
-						out.Edges = []pullrequestNode{}
+						out.Nodes = []repoName{}
 					}
 				} else {
-					out.Edges = (out.Edges)[:0]
+					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v16 pullrequestNode
-					(v16).UnmarshalEasyJSON(in)
-					out.Edges = append(out.Edges, v16)
+					var v13 repoName
+					(v13).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v13)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2291,7 +2122,11 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal20(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal20(out *jwriter.Writer, in pullrequests) {
+func easyjson2a877177Encode13(out *jwriter.Writer, in struct {
+	TotalCount int        `json:"totalCount"`
+	PageInfo   pageInfo   `json:"pageInfo"`
+	Nodes      []repoName `json:"nodes"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2306,48 +2141,24 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal20(out *jwriter.Writer, i
 		(in.PageInfo).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"edges\":"
+		const prefix string = ",\"nodes\":"
 		out.RawString(prefix)
-		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v17, v18 := range in.Edges {
-				if v17 > 0 {
+			for v14, v15 := range in.Nodes {
+				if v14 > 0 {
 					out.RawByte(',')
 				}
-				(v18).MarshalEasyJSON(out)
+				(v15).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v pullrequests) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal20(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequests) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal20(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequests) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal20(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequests) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal20(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal21(in *jlexer.Lexer, out *pullrequestreviewsNode) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal13(in *jlexer.Lexer, out *repoProjectsResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2366,10 +2177,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal21(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "cursor":
-			out.Cursor = string(in.String())
-		case "node":
-			(out.Node).UnmarshalEasyJSON(in)
+		case "repository":
+			easyjson2a877177Decode14(in, &out.Repository)
 		default:
 			in.SkipRecursive()
 		}
@@ -2380,47 +2189,44 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal21(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal21(out *jwriter.Writer, in pullrequestreviewsNode) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal13(out *jwriter.Writer, in repoProjectsResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"cursor\":"
+		const prefix string = ",\"repository\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Cursor))
-	}
-	{
-		const prefix string = ",\"node\":"
-		out.RawString(prefix)
-		(in.Node).MarshalEasyJSON(out)
+		easyjson2a877177Encode14(out, in.Repository)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestreviewsNode) MarshalJSON() ([]byte, error) {
+func (v repoProjectsResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal21(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestreviewsNode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal21(w, v)
+func (v repoProjectsResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestreviewsNode) UnmarshalJSON(data []byte) error {
+func (v *repoProjectsResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal21(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestreviewsNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal21(l, v)
+func (v *repoProjectsResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal13(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal22(in *jlexer.Lexer, out *pullrequestreviews) {
+func easyjson2a877177Decode14(in *jlexer.Lexer, out *struct {
+	Projects repoProjectNode `json:"projects"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2439,33 +2245,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal22(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "totalCount":
-			out.TotalCount = int(in.Int())
-		case "pageInfo":
-			(out.PageInfo).UnmarshalEasyJSON(in)
-		case "edges":
-			if in.IsNull() {
-				in.Skip()
-				out.Edges = nil
-			} else {
-				in.Delim('[')
-				if out.Edges == nil {
-					if !in.IsDelim(']') {
-						out.Edges = make([]pullrequestreviewsNode, 0, 1)
-					} else {
-						out.Edges = []pullrequestreviewsNode{}
-					}
-				} else {
-					out.Edges = (out.Edges)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v19 pullrequestreviewsNode
-					(v19).UnmarshalEasyJSON(in)
-					out.Edges = append(out.Edges, v19)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "projects":
+			(out.Projects).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -2476,63 +2257,88 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal22(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal22(out *jwriter.Writer, in pullrequestreviews) {
+func easyjson2a877177Encode14(out *jwriter.Writer, in struct {
+	Projects repoProjectNode `json:"projects"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"totalCount\":"
+		const prefix string = ",\"projects\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.TotalCount))
+		(in.Projects).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"pageInfo\":"
-		out.RawString(prefix)
-		(in.PageInfo).MarshalEasyJSON(out)
+	out.RawByte('}')
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal14(in *jlexer.Lexer, out *repoProjectResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"edges\":"
-		out.RawString(prefix)
-		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v20, v21 := range in.Edges {
-				if v20 > 0 {
-					out.RawByte(',')
-				}
-				(v21).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "repository":
+			easyjson2a877177Decode15(in, &out.Repository)
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal14(out *jwriter.Writer, in repoProjectResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"repository\":"
+		out.RawString(prefix[1:])
+		easyjson2a877177Encode15(out, in.Repository)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestreviews) MarshalJSON() ([]byte, error) {
+func (v repoProjectResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal22(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestreviews) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal22(w, v)
+func (v repoProjectResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestreviews) UnmarshalJSON(data []byte) error {
+func (v *repoProjectResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal22(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestreviews) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal22(l, v)
+func (v *repoProjectResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal14(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal23(in *jlexer.Lexer, out *pullrequestreviewrequestsNode) {
+func easyjson2a877177Decode15(in *jlexer.Lexer, out *struct {
+	Project repoProject `json:"project"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2551,10 +2357,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal23(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "cursor":
-			out.Cursor = string(in.String())
-		case "node":
-			(out.Node).UnmarshalEasyJSON(in)
+		case "project":
+			(out.Project).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -2565,47 +2369,20 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal23(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal23(out *jwriter.Writer, in pullrequestreviewrequestsNode) {
+func easyjson2a877177Encode15(out *jwriter.Writer, in struct {
+	Project repoProject `json:"project"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"cursor\":"
+		const prefix string = ",\"project\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Cursor))
-	}
-	{
-		const prefix string = ",\"node\":"
-		out.RawString(prefix)
-		(in.Node).MarshalEasyJSON(out)
+		(in.Project).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v pullrequestreviewrequestsNode) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal23(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestreviewrequestsNode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal23(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestreviewrequestsNode) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal23(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestreviewrequestsNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal23(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal24(in *jlexer.Lexer, out *pullrequestreviewrequests) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal15(in *jlexer.Lexer, out *repoProjectNode) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2624,29 +2401,25 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal24(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "totalCount":
-			out.TotalCount = int(in.Int())
-		case "pageInfo":
-			(out.PageInfo).UnmarshalEasyJSON(in)
-		case "edges":
+		case "nodes":
 			if in.IsNull() {
 				in.Skip()
-				out.Edges = nil
+				out.Nodes = nil
 			} else {
 				in.Delim('[')
-				if out.Edges == nil {
+				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.Edges = make([]pullrequestreviewrequestsNode, 0, 1)
+						out.Nodes = make([]repoProject, 0, 1)
 					} else {
-						out.Edges = []pullrequestreviewrequestsNode{}
+						out.Nodes = []repoProject{}
 					}
 				} else {
-					out.Edges = (out.Edges)[:0]
+					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v22 pullrequestreviewrequestsNode
-					(v22).UnmarshalEasyJSON(in)
-					out.Edges = append(out.Edges, v22)
+					var v16 repoProject
+					(v16).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v16)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2661,32 +2434,22 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal24(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal24(out *jwriter.Writer, in pullrequestreviewrequests) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal15(out *jwriter.Writer, in repoProjectNode) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"totalCount\":"
+		const prefix string = ",\"nodes\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.TotalCount))
-	}
-	{
-		const prefix string = ",\"pageInfo\":"
-		out.RawString(prefix)
-		(in.PageInfo).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"edges\":"
-		out.RawString(prefix)
-		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v23, v24 := range in.Edges {
-				if v23 > 0 {
+			for v17, v18 := range in.Nodes {
+				if v17 > 0 {
 					out.RawByte(',')
 				}
-				(v24).MarshalEasyJSON(out)
+				(v18).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -2695,29 +2458,29 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal24(out *jwriter.Writer, i
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestreviewrequests) MarshalJSON() ([]byte, error) {
+func (v repoProjectNode) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal24(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestreviewrequests) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal24(w, v)
+func (v repoProjectNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestreviewrequests) UnmarshalJSON(data []byte) error {
+func (v *repoProjectNode) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal24(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestreviewrequests) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal24(l, v)
+func (v *repoProjectNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal15(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal25(in *jlexer.Lexer, out *pullrequestreviewrequest) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal16(in *jlexer.Lexer, out *repoProjectColumnNodes) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2736,10 +2499,29 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal25(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "requestedReviewer":
-			(out.RequestedReviewer).UnmarshalEasyJSON(in)
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]repoProjectColumn, 0, 1)
+					} else {
+						out.Nodes = []repoProjectColumn{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 repoProjectColumn
+					(v19).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2750,47 +2532,53 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal25(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal25(out *jwriter.Writer, in pullrequestreviewrequest) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal16(out *jwriter.Writer, in repoProjectColumnNodes) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"nodes\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"requestedReviewer\":"
-		out.RawString(prefix)
-		(in.RequestedReviewer).MarshalEasyJSON(out)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Nodes {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestreviewrequest) MarshalJSON() ([]byte, error) {
+func (v repoProjectColumnNodes) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal25(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestreviewrequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal25(w, v)
+func (v repoProjectColumnNodes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestreviewrequest) UnmarshalJSON(data []byte) error {
+func (v *repoProjectColumnNodes) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal25(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestreviewrequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal25(l, v)
+func (v *repoProjectColumnNodes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal16(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal26(in *jlexer.Lexer, out *pullrequestreview) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal17(in *jlexer.Lexer, out *repoProjectColumn) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2811,16 +2599,12 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal26(in *jlexer.Lexer, out
 		switch key {
 		case "id":
 			out.ID = string(in.String())
-		case "state":
-			out.State = string(in.String())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "author":
-			(out.Author).UnmarshalEasyJSON(in)
-		case "url":
-			out.URL = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "purpose":
+			out.Purpose = string(in.String())
+		case "cards":
+			(out.Cards).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -2831,7 +2615,7 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal26(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal26(out *jwriter.Writer, in pullrequestreview) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal17(out *jwriter.Writer, in repoProjectColumn) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2841,52 +2625,47 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal26(out *jwriter.Writer, i
 		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"state\":"
-		out.RawString(prefix)
-		out.String(string(in.State))
-	}
-	{
-		const prefix string = ",\"createdAt\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"author\":"
+		const prefix string = ",\"purpose\":"
 		out.RawString(prefix)
-		(in.Author).MarshalEasyJSON(out)
+		out.String(string(in.Purpose))
 	}
 	{
-		const prefix string = ",\"url\":"
+		const prefix string = ",\"cards\":"
 		out.RawString(prefix)
-		out.String(string(in.URL))
+		(in.Cards).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestreview) MarshalJSON() ([]byte, error) {
+func (v repoProjectColumn) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal26(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestreview) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal26(w, v)
+func (v repoProjectColumn) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestreview) UnmarshalJSON(data []byte) error {
+func (v *repoProjectColumn) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal26(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestreview) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal26(l, v)
+func (v *repoProjectColumn) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal17(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal27(in *jlexer.Lexer, out *pullrequestcommits) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal18(in *jlexer.Lexer, out *repoProjectCardNodes) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2905,29 +2684,25 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal27(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "totalCount":
-			out.TotalCount = int(in.Int())
-		case "pageInfo":
-			(out.PageInfo).UnmarshalEasyJSON(in)
-		case "edges":
+		case "nodes":
 			if in.IsNull() {
 				in.Skip()
-				out.Edges = nil
+				out.Nodes = nil
 			} else {
 				in.Delim('[')
-				if out.Edges == nil {
+				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.Edges = make([]pullrequestcommitNode, 0, 1)
+						out.Nodes = make([]repoProjectCard, 0, 1)
 					} else {
-						out.Edges = []pullrequestcommitNode{}
+						out.Nodes = []repoProjectCard{}
 					}
 				} else {
-					out.Edges = (out.Edges)[:0]
+					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v25 pullrequestcommitNode
-					(v25).UnmarshalEasyJSON(in)
-					out.Edges = append(out.Edges, v25)
+					var v22 repoProjectCard
+					(v22).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v22)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -2942,32 +2717,22 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal27(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal27(out *jwriter.Writer, in pullrequestcommits) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal18(out *jwriter.Writer, in repoProjectCardNodes) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"totalCount\":"
+		const prefix string = ",\"nodes\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.TotalCount))
-	}
-	{
-		const prefix string = ",\"pageInfo\":"
-		out.RawString(prefix)
-		(in.PageInfo).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"edges\":"
-		out.RawString(prefix)
-		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v26, v27 := range in.Edges {
-				if v26 > 0 {
+			for v23, v24 := range in.Nodes {
+				if v23 > 0 {
 					out.RawByte(',')
 				}
-				(v27).MarshalEasyJSON(out)
+				(v24).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -2976,29 +2741,29 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal27(out *jwriter.Writer, i
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestcommits) MarshalJSON() ([]byte, error) {
+func (v repoProjectCardNodes) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal27(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestcommits) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal27(w, v)
+func (v repoProjectCardNodes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestcommits) UnmarshalJSON(data []byte) error {
+func (v *repoProjectCardNodes) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal27(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestcommits) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal27(l, v)
+func (v *repoProjectCardNodes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal18(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal28(in *jlexer.Lexer, out *pullrequestcommitNode) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal19(in *jlexer.Lexer, out *repoProjectCardContent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3017,10 +2782,10 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal28(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "cursor":
-			out.Cursor = string(in.String())
-		case "node":
-			(out.Node).UnmarshalEasyJSON(in)
+		case "__typename":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3031,47 +2796,47 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal28(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal28(out *jwriter.Writer, in pullrequestcommitNode) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal19(out *jwriter.Writer, in repoProjectCardContent) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"cursor\":"
+		const prefix string = ",\"__typename\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Cursor))
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"node\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix)
-		(in.Node).MarshalEasyJSON(out)
+		out.String(string(in.ID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestcommitNode) MarshalJSON() ([]byte, error) {
+func (v repoProjectCardContent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal28(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestcommitNode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal28(w, v)
+func (v repoProjectCardContent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestcommitNode) UnmarshalJSON(data []byte) error {
+func (v *repoProjectCardContent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal28(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestcommitNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal28(l, v)
+func (v *repoProjectCardContent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal19(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal29(in *jlexer.Lexer, out *pullrequestcommit) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal20(in *jlexer.Lexer, out *repoProjectCard) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3090,8 +2855,22 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal29(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "commit":
-			(out.Commit).UnmarshalEasyJSON(in)
+		case "id":
+			out.ID = string(in.String())
+		case "state":
+			out.State = string(in.String())
+		case "note":
+			out.Note = string(in.String())
+		case "content":
+			if in.IsNull() {
+				in.Skip()
+				out.Content = nil
+			} else {
+				if out.Content == nil {
+					out.Content = new(repoProjectCardContent)
+				}
+				(*out.Content).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3102,42 +2881,61 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal29(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal29(out *jwriter.Writer, in pullrequestcommit) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal20(out *jwriter.Writer, in repoProjectCard) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"commit\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		(in.Commit).MarshalEasyJSON(out)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix)
+		if in.Content == nil {
+			out.RawString("null")
+		} else {
+			(*in.Content).MarshalEasyJSON(out)
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestcommit) MarshalJSON() ([]byte, error) {
+func (v repoProjectCard) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal29(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestcommit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal29(w, v)
+func (v repoProjectCard) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestcommit) UnmarshalJSON(data []byte) error {
+func (v *repoProjectCard) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal29(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestcommit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal29(l, v)
+func (v *repoProjectCard) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal20(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal30(in *jlexer.Lexer, out *pullrequestcommentsNode) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal21(in *jlexer.Lexer, out *repoProject) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3156,10 +2954,18 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal30(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "cursor":
-			out.Cursor = string(in.String())
-		case "node":
-			(out.Node).UnmarshalEasyJSON(in)
+		case "name":
+			out.Name = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "columns":
+			(out.Columns).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -3170,47 +2976,62 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal30(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal30(out *jwriter.Writer, in pullrequestcommentsNode) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal21(out *jwriter.Writer, in repoProject) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"cursor\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Cursor))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"node\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix)
-		(in.Node).MarshalEasyJSON(out)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"columns\":"
+		out.RawString(prefix)
+		(in.Columns).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestcommentsNode) MarshalJSON() ([]byte, error) {
+func (v repoProject) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal30(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestcommentsNode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal30(w, v)
+func (v repoProject) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestcommentsNode) UnmarshalJSON(data []byte) error {
+func (v *repoProject) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal30(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestcommentsNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal30(l, v)
+func (v *repoProject) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal21(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal31(in *jlexer.Lexer, out *pullrequestcomments) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal22(in *jlexer.Lexer, out *repoName) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3229,33 +3050,20 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal31(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "totalCount":
-			out.TotalCount = int(in.Int())
-		case "pageInfo":
-			(out.PageInfo).UnmarshalEasyJSON(in)
-		case "edges":
-			if in.IsNull() {
-				in.Skip()
-				out.Edges = nil
-			} else {
-				in.Delim('[')
-				if out.Edges == nil {
-					if !in.IsDelim(']') {
-						out.Edges = make([]pullrequestcommentsNode, 0, 1)
-					} else {
-						out.Edges = []pullrequestcommentsNode{}
-					}
-				} else {
-					out.Edges = (out.Edges)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v28 pullrequestcommentsNode
-					(v28).UnmarshalEasyJSON(in)
-					out.Edges = append(out.Edges, v28)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.RepoName = string(in.String())
+		case "nameWithOwner":
+			out.Name = string(in.String())
+		case "isPrivate":
+			out.IsPrivate = bool(in.Bool())
+		case "isArchived":
+			out.IsArchived = bool(in.Bool())
+		case "hasProjectsEnabled":
+			out.HasProjectsEnabled = bool(in.Bool())
+		case "hasIssuesEnabled":
+			out.HasIssuesEnabled = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -3266,63 +3074,72 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal31(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal31(out *jwriter.Writer, in pullrequestcomments) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal22(out *jwriter.Writer, in repoName) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"totalCount\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.TotalCount))
+		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"pageInfo\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		(in.PageInfo).MarshalEasyJSON(out)
+		out.String(string(in.RepoName))
 	}
 	{
-		const prefix string = ",\"edges\":"
+		const prefix string = ",\"nameWithOwner\":"
 		out.RawString(prefix)
-		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v29, v30 := range in.Edges {
-				if v29 > 0 {
-					out.RawByte(',')
-				}
-				(v30).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"isPrivate\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPrivate))
+	}
+	{
+		const prefix string = ",\"isArchived\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsArchived))
+	}
+	{
+		const prefix string = ",\"hasProjectsEnabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasProjectsEnabled))
+	}
+	{
+		const prefix string = ",\"hasIssuesEnabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasIssuesEnabled))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestcomments) MarshalJSON() ([]byte, error) {
+func (v repoName) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal31(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestcomments) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal31(w, v)
+func (v repoName) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestcomments) UnmarshalJSON(data []byte) error {
+func (v *repoName) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal31(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestcomments) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal31(l, v)
+func (v *repoName) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal22(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal32(in *jlexer.Lexer, out *pullrequestcomment) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal23(in *jlexer.Lexer, out *repoJob) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3341,22 +3158,6 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal32(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "author":
-			(out.Author).UnmarshalEasyJSON(in)
-		case "url":
-			out.URL = string(in.String())
-		case "bodyHTML":
-			out.Body = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3367,67 +3168,37 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal32(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal32(out *jwriter.Writer, in pullrequestcomment) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal23(out *jwriter.Writer, in repoJob) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"author\":"
-		out.RawString(prefix)
-		(in.Author).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"url\":"
-		out.RawString(prefix)
-		out.String(string(in.URL))
-	}
-	{
-		const prefix string = ",\"bodyHTML\":"
-		out.RawString(prefix)
-		out.String(string(in.Body))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestcomment) MarshalJSON() ([]byte, error) {
+func (v repoJob) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal32(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal23(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestcomment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal32(w, v)
+func (v repoJob) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal23(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestcomment) UnmarshalJSON(data []byte) error {
+func (v *repoJob) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal32(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal23(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestcomment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal32(l, v)
+func (v *repoJob) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal23(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal33(in *jlexer.Lexer, out *pullrequestTimelineItems) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal24(in *jlexer.Lexer, out *repoCheckpoint) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3446,25 +3217,49 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal33(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "nodes":
+		case "repo":
+			(out.Repo).UnmarshalEasyJSON(in)
+		case "pullrequests":
+			(out.PullRequests).UnmarshalEasyJSON(in)
+		case "issues":
+			(out.Issues).UnmarshalEasyJSON(in)
+		case "milestones":
+			(out.Milestones).UnmarshalEasyJSON(in)
+		case "projects":
+			(out.Projects).UnmarshalEasyJSON(in)
+		case "commits":
+			(out.Commits).UnmarshalEasyJSON(in)
+		case "commit_comments":
+			(out.CommitComments).UnmarshalEasyJSON(in)
+		case "branches":
+			(out.Branches).UnmarshalEasyJSON(in)
+		case "releases":
+			(out.Releases).UnmarshalEasyJSON(in)
+		case "deployments":
+			(out.Deployments).UnmarshalEasyJSON(in)
+		case "builds":
+			(out.Builds).UnmarshalEasyJSON(in)
+		case "security_alerts":
+			(out.SecurityAlerts).UnmarshalEasyJSON(in)
+		case "jobs":
 			if in.IsNull() {
 				in.Skip()
-				out.Nodes = nil
+				out.Jobs = nil
 			} else {
 				in.Delim('[')
-				if out.Nodes == nil {
+				if out.Jobs == nil {
 					if !in.IsDelim(']') {
-						out.Nodes = make([]author, 0, 1)
+						out.Jobs = make([]checkpointJob, 0, 1)
 					} else {
-						out.Nodes = []author{}
+						out.Jobs = []checkpointJob{}
 					}
 				} else {
-					out.Nodes = (out.Nodes)[:0]
+					out.Jobs = (out.Jobs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v31 author
-					(v31).UnmarshalEasyJSON(in)
-					out.Nodes = append(out.Nodes, v31)
+					var v25 checkpointJob
+					(v25).UnmarshalEasyJSON(in)
+					out.Jobs = append(out.Jobs, v25)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3479,126 +3274,111 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal33(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal33(out *jwriter.Writer, in pullrequestTimelineItems) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal24(out *jwriter.Writer, in repoCheckpoint) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"nodes\":"
+		const prefix string = ",\"repo\":"
 		out.RawString(prefix[1:])
-		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v32, v33 := range in.Nodes {
-				if v32 > 0 {
-					out.RawByte(',')
-				}
-				(v33).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		(in.Repo).MarshalEasyJSON(out)
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v pullrequestTimelineItems) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal33(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestTimelineItems) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal33(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestTimelineItems) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal33(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestTimelineItems) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal33(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal34(in *jlexer.Lexer, out *pullrequestPagedCommitsResult) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
+	{
+		const prefix string = ",\"pullrequests\":"
+		out.RawString(prefix)
+		(in.PullRequests).MarshalEasyJSON(out)
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "rateLimit":
-			(out.RateLimit).UnmarshalEasyJSON(in)
-		case "node":
-			(out.Node).UnmarshalEasyJSON(in)
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
+	{
+		const prefix string = ",\"issues\":"
+		out.RawString(prefix)
+		(in.Issues).MarshalEasyJSON(out)
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	{
+		const prefix string = ",\"milestones\":"
+		out.RawString(prefix)
+		(in.Milestones).MarshalEasyJSON(out)
 	}
-}
-func easyjson2a877177EncodeGithubComPinptGithubInternal34(out *jwriter.Writer, in pullrequestPagedCommitsResult) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
-		const prefix string = ",\"rateLimit\":"
-		out.RawString(prefix[1:])
-		(in.RateLimit).MarshalEasyJSON(out)
+		const prefix string = ",\"projects\":"
+		out.RawString(prefix)
+		(in.Projects).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"node\":"
+		const prefix string = ",\"commits\":"
 		out.RawString(prefix)
-		(in.Node).MarshalEasyJSON(out)
+		(in.Commits).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"commit_comments\":"
+		out.RawString(prefix)
+		(in.CommitComments).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"branches\":"
+		out.RawString(prefix)
+		(in.Branches).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"releases\":"
+		out.RawString(prefix)
+		(in.Releases).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"deployments\":"
+		out.RawString(prefix)
+		(in.Deployments).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"builds\":"
+		out.RawString(prefix)
+		(in.Builds).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"security_alerts\":"
+		out.RawString(prefix)
+		(in.SecurityAlerts).MarshalEasyJSON(out)
+	}
+	if len(in.Jobs) != 0 {
+		const prefix string = ",\"jobs\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v26, v27 := range in.Jobs {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				(v27).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestPagedCommitsResult) MarshalJSON() ([]byte, error) {
+func (v repoCheckpoint) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal34(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal24(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestPagedCommitsResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal34(w, v)
+func (v repoCheckpoint) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal24(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestPagedCommitsResult) UnmarshalJSON(data []byte) error {
+func (v *repoCheckpoint) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal34(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal24(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestPagedCommitsResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal34(l, v)
+func (v *repoCheckpoint) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal24(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal35(in *jlexer.Lexer, out *pullrequestPagedCommits) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal25(in *jlexer.Lexer, out *releasesResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3617,12 +3397,10 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal35(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "totalCount":
-			out.TotalCount = int(in.Int())
-		case "pageInfo":
-			(out.PageInfo).UnmarshalEasyJSON(in)
-		case "commits":
-			(out.Commits).UnmarshalEasyJSON(in)
+		case "rateLimit":
+			(out.RateLimit).UnmarshalEasyJSON(in)
+		case "repository":
+			easyjson2a877177Decode16(in, &out.Repository)
 		default:
 			in.SkipRecursive()
 		}
@@ -3633,52 +3411,52 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal35(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal35(out *jwriter.Writer, in pullrequestPagedCommits) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal25(out *jwriter.Writer, in releasesResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"totalCount\":"
+		const prefix string = ",\"rateLimit\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.TotalCount))
-	}
-	{
-		const prefix string = ",\"pageInfo\":"
-		out.RawString(prefix)
-		(in.PageInfo).MarshalEasyJSON(out)
+		(in.RateLimit).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"commits\":"
+		const prefix string = ",\"repository\":"
 		out.RawString(prefix)
-		(in.Commits).MarshalEasyJSON(out)
+		easyjson2a877177Encode16(out, in.Repository)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestPagedCommits) MarshalJSON() ([]byte, error) {
+func (v releasesResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal35(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal25(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestPagedCommits) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal35(w, v)
+func (v releasesResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal25(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestPagedCommits) UnmarshalJSON(data []byte) error {
+func (v *releasesResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal35(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal25(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestPagedCommits) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal35(l, v)
+func (v *releasesResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal25(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal36(in *jlexer.Lexer, out *pullrequestPagedCommitNode) {
+func easyjson2a877177Decode16(in *jlexer.Lexer, out *struct {
+	Releases struct {
+		PageInfo pageInfo  `json:"pageInfo"`
+		Nodes    []release `json:"nodes"`
+	} `json:"releases"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3697,8 +3475,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal36(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "node":
-			(out.Node).UnmarshalEasyJSON(in)
+		case "releases":
+			easyjson2a877177Decode17(in, &out.Releases)
 		default:
 			in.SkipRecursive()
 		}
@@ -3709,42 +3487,26 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal36(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal36(out *jwriter.Writer, in pullrequestPagedCommitNode) {
+func easyjson2a877177Encode16(out *jwriter.Writer, in struct {
+	Releases struct {
+		PageInfo pageInfo  `json:"pageInfo"`
+		Nodes    []release `json:"nodes"`
+	} `json:"releases"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"node\":"
+		const prefix string = ",\"releases\":"
 		out.RawString(prefix[1:])
-		(in.Node).MarshalEasyJSON(out)
+		easyjson2a877177Encode17(out, in.Releases)
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v pullrequestPagedCommitNode) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal36(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestPagedCommitNode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal36(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestPagedCommitNode) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal36(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestPagedCommitNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal36(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal37(in *jlexer.Lexer, out *pullrequestPagedCommitEdges) {
+func easyjson2a877177Decode17(in *jlexer.Lexer, out *struct {
+	PageInfo pageInfo  `json:"pageInfo"`
+	Nodes    []release `json:"nodes"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3763,27 +3525,27 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal37(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "cursor":
-			out.Cursor = string(in.String())
-		case "edges":
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
 			if in.IsNull() {
 				in.Skip()
-				out.Edges = nil
+				out.Nodes = nil
 			} else {
 				in.Delim('[')
-				if out.Edges == nil {
+				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.Edges = make([]pullrequestPagedCommitNode, 0, 1)
+						out.Nodes = make([]release, 0, 1)
 					} else {
-						out.Edges = []pullrequestPagedCommitNode{}
+						out.Nodes = []release{}
 					}
 				} else {
-					out.Edges = (out.Edges)[:0]
+					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v34 pullrequestPagedCommitNode
-					(v34).UnmarshalEasyJSON(in)
-					out.Edges = append(out.Edges, v34)
+					var v28 release
+					(v28).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v28)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3798,58 +3560,37 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal37(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal37(out *jwriter.Writer, in pullrequestPagedCommitEdges) {
+func easyjson2a877177Encode17(out *jwriter.Writer, in struct {
+	PageInfo pageInfo  `json:"pageInfo"`
+	Nodes    []release `json:"nodes"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"cursor\":"
+		const prefix string = ",\"pageInfo\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Cursor))
+		(in.PageInfo).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"edges\":"
+		const prefix string = ",\"nodes\":"
 		out.RawString(prefix)
-		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v35, v36 := range in.Edges {
-				if v35 > 0 {
+			for v29, v30 := range in.Nodes {
+				if v29 > 0 {
 					out.RawByte(',')
 				}
-				(v36).MarshalEasyJSON(out)
+				(v30).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v pullrequestPagedCommitEdges) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal37(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestPagedCommitEdges) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal37(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestPagedCommitEdges) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal37(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestPagedCommitEdges) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal37(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal38(in *jlexer.Lexer, out *pullrequestPagedCommit) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal26(in *jlexer.Lexer, out *release) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3868,8 +3609,58 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal38(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "commit":
-			(out.Commit).UnmarshalEasyJSON(in)
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "tagName":
+			out.TagName = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "isDraft":
+			out.IsDraft = bool(in.Bool())
+		case "isPrerelease":
+			out.IsPrerelease = bool(in.Bool())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "publishedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.PublishedAt = nil
+			} else {
+				if out.PublishedAt == nil {
+					out.PublishedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.PublishedAt).UnmarshalJSON(data))
+				}
+			}
+		case "author":
+			if in.IsNull() {
+				in.Skip()
+				out.Author = nil
+			} else {
+				if out.Author == nil {
+					out.Author = new(author)
+				}
+				(*out.Author).UnmarshalEasyJSON(in)
+			}
+		case "tagCommit":
+			if in.IsNull() {
+				in.Skip()
+				out.TagCommit = nil
+			} else {
+				if out.TagCommit == nil {
+					out.TagCommit = new(struct {
+						Oid string `json:"oid"`
+					})
+				}
+				easyjson2a877177Decode18(in, out.TagCommit)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3880,42 +3671,106 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal38(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal38(out *jwriter.Writer, in pullrequestPagedCommit) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal26(out *jwriter.Writer, in release) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"commit\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		(in.Commit).MarshalEasyJSON(out)
+		out.String(string(in.ID))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v pullrequestPagedCommit) MarshalJSON() ([]byte, error) {
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"tagName\":"
+		out.RawString(prefix)
+		out.String(string(in.TagName))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"isDraft\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDraft))
+	}
+	{
+		const prefix string = ",\"isPrerelease\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPrerelease))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"publishedAt\":"
+		out.RawString(prefix)
+		if in.PublishedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.PublishedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		if in.Author == nil {
+			out.RawString("null")
+		} else {
+			(*in.Author).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"tagCommit\":"
+		out.RawString(prefix)
+		if in.TagCommit == nil {
+			out.RawString("null")
+		} else {
+			easyjson2a877177Encode18(out, *in.TagCommit)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v release) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal38(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal26(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestPagedCommit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal38(w, v)
+func (v release) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal26(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestPagedCommit) UnmarshalJSON(data []byte) error {
+func (v *release) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal38(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal26(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestPagedCommit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal38(l, v)
+func (v *release) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal26(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal39(in *jlexer.Lexer, out *pullrequestNode) {
+func easyjson2a877177Decode18(in *jlexer.Lexer, out *struct {
+	Oid string `json:"oid"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3934,10 +3789,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal39(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "cursor":
-			out.Cursor = string(in.String())
-		case "node":
-			(out.Node).UnmarshalEasyJSON(in)
+		case "oid":
+			out.Oid = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3948,47 +3801,20 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal39(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal39(out *jwriter.Writer, in pullrequestNode) {
+func easyjson2a877177Encode18(out *jwriter.Writer, in struct {
+	Oid string `json:"oid"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"cursor\":"
+		const prefix string = ",\"oid\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Cursor))
-	}
-	{
-		const prefix string = ",\"node\":"
-		out.RawString(prefix)
-		(in.Node).MarshalEasyJSON(out)
+		out.String(string(in.Oid))
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v pullrequestNode) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal39(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestNode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal39(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestNode) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal39(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal39(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal40(in *jlexer.Lexer, out *pullrequestCommit) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal27(in *jlexer.Lexer, out *rateLimit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4007,24 +3833,16 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal40(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "sha":
-			out.Sha = string(in.String())
-		case "message":
-			out.Message = string(in.String())
-		case "authoredDate":
+		case "limit":
+			out.Limit = int(in.Int())
+		case "cost":
+			out.Cost = int(in.Int())
+		case "remaining":
+			out.Remaining = int(in.Int())
+		case "resetAt":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Date).UnmarshalJSON(data))
+				in.AddError((out.ResetAt).UnmarshalJSON(data))
 			}
-		case "additions":
-			out.Additions = int64(in.Int64())
-		case "deletions":
-			out.Deletions = int64(in.Int64())
-		case "url":
-			out.URL = string(in.String())
-		case "author":
-			(out.Author).UnmarshalEasyJSON(in)
-		case "committer":
-			(out.Committer).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -4035,77 +3853,57 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal40(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal40(out *jwriter.Writer, in pullrequestCommit) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal27(out *jwriter.Writer, in rateLimit) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"sha\":"
+		const prefix string = ",\"limit\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Sha))
-	}
-	{
-		const prefix string = ",\"message\":"
-		out.RawString(prefix)
-		out.String(string(in.Message))
-	}
-	{
-		const prefix string = ",\"authoredDate\":"
-		out.RawString(prefix)
-		out.Raw((in.Date).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"additions\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Additions))
-	}
-	{
-		const prefix string = ",\"deletions\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Deletions))
+		out.Int(int(in.Limit))
 	}
 	{
-		const prefix string = ",\"url\":"
+		const prefix string = ",\"cost\":"
 		out.RawString(prefix)
-		out.String(string(in.URL))
+		out.Int(int(in.Cost))
 	}
 	{
-		const prefix string = ",\"author\":"
+		const prefix string = ",\"remaining\":"
 		out.RawString(prefix)
-		(in.Author).MarshalEasyJSON(out)
+		out.Int(int(in.Remaining))
 	}
 	{
-		const prefix string = ",\"committer\":"
+		const prefix string = ",\"resetAt\":"
 		out.RawString(prefix)
-		(in.Committer).MarshalEasyJSON(out)
+		out.Raw((in.ResetAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequestCommit) MarshalJSON() ([]byte, error) {
+func (v rateLimit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal40(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequestCommit) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal40(w, v)
+func (v rateLimit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequestCommit) UnmarshalJSON(data []byte) error {
+func (v *rateLimit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal40(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequestCommit) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal40(l, v)
+func (v *rateLimit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal27(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal41(in *jlexer.Lexer, out *pullrequest) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal28(in *jlexer.Lexer, out *pullrequests) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4124,58 +3922,33 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal41(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "bodyHTML":
-			out.Body = string(in.String())
-		case "url":
-			out.URL = string(in.String())
-		case "closed":
-			out.Closed = bool(in.Bool())
-		case "draft":
-			out.Draft = bool(in.Bool())
-		case "locked":
-			out.Locked = bool(in.Bool())
-		case "merged":
-			out.Merged = bool(in.Bool())
-		case "number":
-			out.Number = int(in.Int())
-		case "state":
-			out.State = string(in.String())
-		case "title":
-			out.Title = string(in.String())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "mergedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.MergedAt).UnmarshalJSON(data))
+		case "totalCount":
+			out.TotalCount = int(in.Int())
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "edges":
+			if in.IsNull() {
+				in.Skip()
+				out.Edges = nil
+			} else {
+				in.Delim('[')
+				if out.Edges == nil {
+					if !in.IsDelim(']') {
+						out.Edges = make([]pullrequestNode, 0, 1)
+					} else {
+						out.Edges = []pullrequestNode{}
+					}
+				} else {
+					out.Edges = (out.Edges)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 pullrequestNode
+					(v31).UnmarshalEasyJSON(in)
+					out.Edges = append(out.Edges, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
-		case "author":
-			(out.Author).UnmarshalEasyJSON(in)
-		case "branch":
-			out.Branch = string(in.String())
-		case "mergeCommit":
-			(out.MergeCommit).UnmarshalEasyJSON(in)
-		case "mergedBy":
-			(out.MergedBy).UnmarshalEasyJSON(in)
-		case "commits":
-			(out.Commits).UnmarshalEasyJSON(in)
-		case "reviews":
-			(out.Reviews).UnmarshalEasyJSON(in)
-		case "reviewRequests":
-			(out.ReviewRequests).UnmarshalEasyJSON(in)
-		case "comments":
-			(out.Comments).UnmarshalEasyJSON(in)
-		case "timelineItems":
-			(out.TimelineItems).UnmarshalEasyJSON(in)
-		case "labels":
-			easyjson2a877177Decode8(in, &out.Labels)
 		default:
 			in.SkipRecursive()
 		}
@@ -4186,7 +3959,6198 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal41(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal41(out *jwriter.Writer, in pullrequest) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal28(out *jwriter.Writer, in pullrequests) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"totalCount\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalCount))
+	}
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix)
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"edges\":"
+		out.RawString(prefix)
+		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Edges {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				(v33).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequests) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequests) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequests) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequests) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal28(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal29(in *jlexer.Lexer, out *pullrequestreviewthreads) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "totalCount":
+			out.TotalCount = int(in.Int())
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]pullrequestreviewthread, 0, 1)
+					} else {
+						out.Nodes = []pullrequestreviewthread{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 pullrequestreviewthread
+					(v34).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal29(out *jwriter.Writer, in pullrequestreviewthreads) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"totalCount\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalCount))
+	}
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix)
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Nodes {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				(v36).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestreviewthreads) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestreviewthreads) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestreviewthreads) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestreviewthreads) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal29(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal30(in *jlexer.Lexer, out *pullrequestreviewthread) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "isResolved":
+			out.IsResolved = bool(in.Bool())
+		case "isOutdated":
+			out.IsOutdated = bool(in.Bool())
+		case "comments":
+			(out.Comments).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal30(out *jwriter.Writer, in pullrequestreviewthread) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"isResolved\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsResolved))
+	}
+	{
+		const prefix string = ",\"isOutdated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsOutdated))
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		(in.Comments).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestreviewthread) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestreviewthread) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestreviewthread) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestreviewthread) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal30(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal31(in *jlexer.Lexer, out *pullrequestreviewsNode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cursor":
+			out.Cursor = string(in.String())
+		case "node":
+			(out.Node).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal31(out *jwriter.Writer, in pullrequestreviewsNode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"cursor\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Cursor))
+	}
+	{
+		const prefix string = ",\"node\":"
+		out.RawString(prefix)
+		(in.Node).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestreviewsNode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestreviewsNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestreviewsNode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestreviewsNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal31(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal32(in *jlexer.Lexer, out *pullrequestreviews) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "totalCount":
+			out.TotalCount = int(in.Int())
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "edges":
+			if in.IsNull() {
+				in.Skip()
+				out.Edges = nil
+			} else {
+				in.Delim('[')
+				if out.Edges == nil {
+					if !in.IsDelim(']') {
+						out.Edges = make([]pullrequestreviewsNode, 0, 1)
+					} else {
+						out.Edges = []pullrequestreviewsNode{}
+					}
+				} else {
+					out.Edges = (out.Edges)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 pullrequestreviewsNode
+					(v37).UnmarshalEasyJSON(in)
+					out.Edges = append(out.Edges, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal32(out *jwriter.Writer, in pullrequestreviews) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"totalCount\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalCount))
+	}
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix)
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"edges\":"
+		out.RawString(prefix)
+		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.Edges {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				(v39).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestreviews) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestreviews) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestreviews) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestreviews) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal32(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal33(in *jlexer.Lexer, out *pullrequestreviewrequestsNode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cursor":
+			out.Cursor = string(in.String())
+		case "node":
+			(out.Node).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal33(out *jwriter.Writer, in pullrequestreviewrequestsNode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"cursor\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Cursor))
+	}
+	{
+		const prefix string = ",\"node\":"
+		out.RawString(prefix)
+		(in.Node).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestreviewrequestsNode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestreviewrequestsNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestreviewrequestsNode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestreviewrequestsNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal33(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal34(in *jlexer.Lexer, out *pullrequestreviewrequests) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "totalCount":
+			out.TotalCount = int(in.Int())
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "edges":
+			if in.IsNull() {
+				in.Skip()
+				out.Edges = nil
+			} else {
+				in.Delim('[')
+				if out.Edges == nil {
+					if !in.IsDelim(']') {
+						out.Edges = make([]pullrequestreviewrequestsNode, 0, 1)
+					} else {
+						out.Edges = []pullrequestreviewrequestsNode{}
+					}
+				} else {
+					out.Edges = (out.Edges)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v40 pullrequestreviewrequestsNode
+					(v40).UnmarshalEasyJSON(in)
+					out.Edges = append(out.Edges, v40)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal34(out *jwriter.Writer, in pullrequestreviewrequests) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"totalCount\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalCount))
+	}
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix)
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"edges\":"
+		out.RawString(prefix)
+		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v41, v42 := range in.Edges {
+				if v41 > 0 {
+					out.RawByte(',')
+				}
+				(v42).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestreviewrequests) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestreviewrequests) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestreviewrequests) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestreviewrequests) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal34(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal35(in *jlexer.Lexer, out *pullrequestreviewrequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "requestedReviewer":
+			(out.RequestedReviewer).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal35(out *jwriter.Writer, in pullrequestreviewrequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"requestedReviewer\":"
+		out.RawString(prefix)
+		(in.RequestedReviewer).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestreviewrequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestreviewrequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestreviewrequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestreviewrequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal35(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal36(in *jlexer.Lexer, out *pullrequestreviewcomments) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]pullrequestreviewcomment, 0, 1)
+					} else {
+						out.Nodes = []pullrequestreviewcomment{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v43 pullrequestreviewcomment
+					(v43).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v43)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal36(out *jwriter.Writer, in pullrequestreviewcomments) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix[1:])
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v44, v45 := range in.Nodes {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				(v45).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestreviewcomments) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestreviewcomments) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestreviewcomments) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestreviewcomments) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal36(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal37(in *jlexer.Lexer, out *pullrequestreviewcomment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "author":
+			(out.Author).UnmarshalEasyJSON(in)
+		case "url":
+			out.URL = string(in.String())
+		case "bodyHTML":
+			out.Body = string(in.String())
+		case "path":
+			out.Path = string(in.String())
+		case "line":
+			if in.IsNull() {
+				in.Skip()
+				out.Line = nil
+			} else {
+				if out.Line == nil {
+					out.Line = new(int64)
+				}
+				*out.Line = int64(in.Int64())
+			}
+		case "originalLine":
+			if in.IsNull() {
+				in.Skip()
+				out.OriginalLine = nil
+			} else {
+				if out.OriginalLine == nil {
+					out.OriginalLine = new(int64)
+				}
+				*out.OriginalLine = int64(in.Int64())
+			}
+		case "diffHunk":
+			out.DiffHunk = string(in.String())
+		case "outdated":
+			out.Outdated = bool(in.Bool())
+		case "replyTo":
+			if in.IsNull() {
+				in.Skip()
+				out.ReplyTo = nil
+			} else {
+				if out.ReplyTo == nil {
+					out.ReplyTo = new(idProp)
+				}
+				(*out.ReplyTo).UnmarshalEasyJSON(in)
+			}
+		case "pullRequestReview":
+			if in.IsNull() {
+				in.Skip()
+				out.Review = nil
+			} else {
+				if out.Review == nil {
+					out.Review = new(idProp)
+				}
+				(*out.Review).UnmarshalEasyJSON(in)
+			}
+		case "pullRequestReviewThread":
+			if in.IsNull() {
+				in.Skip()
+				out.Thread = nil
+			} else {
+				if out.Thread == nil {
+					out.Thread = new(struct {
+						IsResolved bool `json:"isResolved"`
+					})
+				}
+				easyjson2a877177Decode19(in, out.Thread)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal37(out *jwriter.Writer, in pullrequestreviewcomment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		(in.Author).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"bodyHTML\":"
+		out.RawString(prefix)
+		out.String(string(in.Body))
+	}
+	{
+		const prefix string = ",\"path\":"
+		out.RawString(prefix)
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"line\":"
+		out.RawString(prefix)
+		if in.Line == nil {
+			out.RawString("null")
+		} else {
+			out.Int64(int64(*in.Line))
+		}
+	}
+	{
+		const prefix string = ",\"originalLine\":"
+		out.RawString(prefix)
+		if in.OriginalLine == nil {
+			out.RawString("null")
+		} else {
+			out.Int64(int64(*in.OriginalLine))
+		}
+	}
+	{
+		const prefix string = ",\"diffHunk\":"
+		out.RawString(prefix)
+		out.String(string(in.DiffHunk))
+	}
+	{
+		const prefix string = ",\"outdated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Outdated))
+	}
+	{
+		const prefix string = ",\"replyTo\":"
+		out.RawString(prefix)
+		if in.ReplyTo == nil {
+			out.RawString("null")
+		} else {
+			(*in.ReplyTo).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"pullRequestReview\":"
+		out.RawString(prefix)
+		if in.Review == nil {
+			out.RawString("null")
+		} else {
+			(*in.Review).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"pullRequestReviewThread\":"
+		out.RawString(prefix)
+		if in.Thread == nil {
+			out.RawString("null")
+		} else {
+			easyjson2a877177Encode19(out, *in.Thread)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestreviewcomment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestreviewcomment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestreviewcomment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestreviewcomment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal37(l, v)
+}
+func easyjson2a877177Decode19(in *jlexer.Lexer, out *struct {
+	IsResolved bool `json:"isResolved"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "isResolved":
+			out.IsResolved = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177Encode19(out *jwriter.Writer, in struct {
+	IsResolved bool `json:"isResolved"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"isResolved\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsResolved))
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal38(in *jlexer.Lexer, out *pullrequestreview) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "state":
+			out.State = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "author":
+			(out.Author).UnmarshalEasyJSON(in)
+		case "url":
+			out.URL = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal38(out *jwriter.Writer, in pullrequestreview) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		(in.Author).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestreview) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestreview) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestreview) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestreview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal38(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal39(in *jlexer.Lexer, out *pullrequestfiles) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "totalCount":
+			out.TotalCount = int(in.Int())
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]pullrequestfile, 0, 1)
+					} else {
+						out.Nodes = []pullrequestfile{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 pullrequestfile
+					(v46).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal39(out *jwriter.Writer, in pullrequestfiles) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"totalCount\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalCount))
+	}
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix)
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v47, v48 := range in.Nodes {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				(v48).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestfiles) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestfiles) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestfiles) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestfiles) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal39(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal40(in *jlexer.Lexer, out *pullrequestfile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "path":
+			out.Path = string(in.String())
+		case "additions":
+			out.Additions = int64(in.Int64())
+		case "deletions":
+			out.Deletions = int64(in.Int64())
+		case "changeType":
+			out.ChangeType = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal40(out *jwriter.Writer, in pullrequestfile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"path\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Path))
+	}
+	{
+		const prefix string = ",\"additions\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Additions))
+	}
+	{
+		const prefix string = ",\"deletions\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Deletions))
+	}
+	{
+		const prefix string = ",\"changeType\":"
+		out.RawString(prefix)
+		out.String(string(in.ChangeType))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestfile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestfile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal40(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal41(in *jlexer.Lexer, out *pullrequestcommits) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "totalCount":
+			out.TotalCount = int(in.Int())
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "edges":
+			if in.IsNull() {
+				in.Skip()
+				out.Edges = nil
+			} else {
+				in.Delim('[')
+				if out.Edges == nil {
+					if !in.IsDelim(']') {
+						out.Edges = make([]pullrequestcommitNode, 0, 1)
+					} else {
+						out.Edges = []pullrequestcommitNode{}
+					}
+				} else {
+					out.Edges = (out.Edges)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v49 pullrequestcommitNode
+					(v49).UnmarshalEasyJSON(in)
+					out.Edges = append(out.Edges, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal41(out *jwriter.Writer, in pullrequestcommits) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"totalCount\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalCount))
+	}
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix)
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"edges\":"
+		out.RawString(prefix)
+		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v50, v51 := range in.Edges {
+				if v50 > 0 {
+					out.RawByte(',')
+				}
+				(v51).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestcommits) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestcommits) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestcommits) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestcommits) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal41(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal42(in *jlexer.Lexer, out *pullrequestcommitNode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cursor":
+			out.Cursor = string(in.String())
+		case "node":
+			(out.Node).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal42(out *jwriter.Writer, in pullrequestcommitNode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"cursor\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Cursor))
+	}
+	{
+		const prefix string = ",\"node\":"
+		out.RawString(prefix)
+		(in.Node).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestcommitNode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestcommitNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestcommitNode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestcommitNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal42(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal43(in *jlexer.Lexer, out *pullrequestcommit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "commit":
+			(out.Commit).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal43(out *jwriter.Writer, in pullrequestcommit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"commit\":"
+		out.RawString(prefix[1:])
+		(in.Commit).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestcommit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestcommit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestcommit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestcommit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal43(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal44(in *jlexer.Lexer, out *pullrequestcommentsNode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cursor":
+			out.Cursor = string(in.String())
+		case "node":
+			(out.Node).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal44(out *jwriter.Writer, in pullrequestcommentsNode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"cursor\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Cursor))
+	}
+	{
+		const prefix string = ",\"node\":"
+		out.RawString(prefix)
+		(in.Node).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestcommentsNode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestcommentsNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestcommentsNode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestcommentsNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal44(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal45(in *jlexer.Lexer, out *pullrequestcomments) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "totalCount":
+			out.TotalCount = int(in.Int())
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "edges":
+			if in.IsNull() {
+				in.Skip()
+				out.Edges = nil
+			} else {
+				in.Delim('[')
+				if out.Edges == nil {
+					if !in.IsDelim(']') {
+						out.Edges = make([]pullrequestcommentsNode, 0, 1)
+					} else {
+						out.Edges = []pullrequestcommentsNode{}
+					}
+				} else {
+					out.Edges = (out.Edges)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v52 pullrequestcommentsNode
+					(v52).UnmarshalEasyJSON(in)
+					out.Edges = append(out.Edges, v52)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal45(out *jwriter.Writer, in pullrequestcomments) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"totalCount\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalCount))
+	}
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix)
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"edges\":"
+		out.RawString(prefix)
+		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v53, v54 := range in.Edges {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				(v54).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestcomments) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestcomments) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestcomments) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestcomments) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal45(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal46(in *jlexer.Lexer, out *pullrequestcomment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "author":
+			(out.Author).UnmarshalEasyJSON(in)
+		case "url":
+			out.URL = string(in.String())
+		case "bodyHTML":
+			out.Body = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal46(out *jwriter.Writer, in pullrequestcomment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		(in.Author).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"bodyHTML\":"
+		out.RawString(prefix)
+		out.String(string(in.Body))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestcomment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestcomment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestcomment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestcomment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal46(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal47(in *jlexer.Lexer, out *pullrequestTimelineItems) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]pullrequestTimelineItem, 0, 1)
+					} else {
+						out.Nodes = []pullrequestTimelineItem{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v55 pullrequestTimelineItem
+					(v55).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v55)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal47(out *jwriter.Writer, in pullrequestTimelineItems) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix[1:])
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v56, v57 := range in.Nodes {
+				if v56 > 0 {
+					out.RawByte(',')
+				}
+				(v57).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestTimelineItems) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestTimelineItems) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestTimelineItems) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestTimelineItems) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal47(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal48(in *jlexer.Lexer, out *pullrequestTimelineItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "actor":
+			if in.IsNull() {
+				in.Skip()
+				out.Actor = nil
+			} else {
+				if out.Actor == nil {
+					out.Actor = new(author)
+				}
+				(*out.Actor).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal48(out *jwriter.Writer, in pullrequestTimelineItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"actor\":"
+		out.RawString(prefix)
+		if in.Actor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Actor).MarshalEasyJSON(out)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestTimelineItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestTimelineItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestTimelineItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestTimelineItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal48(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal49(in *jlexer.Lexer, out *pullrequestPagedCommitsResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rateLimit":
+			(out.RateLimit).UnmarshalEasyJSON(in)
+		case "node":
+			(out.Node).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal49(out *jwriter.Writer, in pullrequestPagedCommitsResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rateLimit\":"
+		out.RawString(prefix[1:])
+		(in.RateLimit).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"node\":"
+		out.RawString(prefix)
+		(in.Node).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestPagedCommitsResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal49(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestPagedCommitsResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestPagedCommitsResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestPagedCommitsResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal49(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal50(in *jlexer.Lexer, out *pullrequestPagedCommits) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "totalCount":
+			out.TotalCount = int(in.Int())
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "commits":
+			(out.Commits).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal50(out *jwriter.Writer, in pullrequestPagedCommits) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"totalCount\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalCount))
+	}
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix)
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"commits\":"
+		out.RawString(prefix)
+		(in.Commits).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestPagedCommits) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestPagedCommits) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestPagedCommits) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestPagedCommits) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal50(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal51(in *jlexer.Lexer, out *pullrequestPagedCommitNode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "node":
+			(out.Node).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal51(out *jwriter.Writer, in pullrequestPagedCommitNode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"node\":"
+		out.RawString(prefix[1:])
+		(in.Node).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestPagedCommitNode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestPagedCommitNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestPagedCommitNode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestPagedCommitNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal51(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal52(in *jlexer.Lexer, out *pullrequestPagedCommitEdges) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cursor":
+			out.Cursor = string(in.String())
+		case "edges":
+			if in.IsNull() {
+				in.Skip()
+				out.Edges = nil
+			} else {
+				in.Delim('[')
+				if out.Edges == nil {
+					if !in.IsDelim(']') {
+						out.Edges = make([]pullrequestPagedCommitNode, 0, 1)
+					} else {
+						out.Edges = []pullrequestPagedCommitNode{}
+					}
+				} else {
+					out.Edges = (out.Edges)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 pullrequestPagedCommitNode
+					(v58).UnmarshalEasyJSON(in)
+					out.Edges = append(out.Edges, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal52(out *jwriter.Writer, in pullrequestPagedCommitEdges) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"cursor\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Cursor))
+	}
+	{
+		const prefix string = ",\"edges\":"
+		out.RawString(prefix)
+		if in.Edges == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v59, v60 := range in.Edges {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				(v60).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestPagedCommitEdges) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestPagedCommitEdges) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestPagedCommitEdges) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestPagedCommitEdges) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal52(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal53(in *jlexer.Lexer, out *pullrequestPagedCommit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "commit":
+			(out.Commit).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal53(out *jwriter.Writer, in pullrequestPagedCommit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"commit\":"
+		out.RawString(prefix[1:])
+		(in.Commit).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestPagedCommit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestPagedCommit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestPagedCommit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestPagedCommit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal53(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal54(in *jlexer.Lexer, out *pullrequestNode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "cursor":
+			out.Cursor = string(in.String())
+		case "node":
+			(out.Node).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal54(out *jwriter.Writer, in pullrequestNode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"cursor\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Cursor))
+	}
+	{
+		const prefix string = ",\"node\":"
+		out.RawString(prefix)
+		(in.Node).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestNode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestNode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal54(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal55(in *jlexer.Lexer, out *pullrequestHeadCommit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]struct {
+							Commit struct {
+								Oid    string `json:"oid"`
+								Status *struct {
+									Contexts []commitStatus `json:"contexts"`
+								} `json:"status"`
+							} `json:"commit"`
+						}, 0, 2)
+					} else {
+						out.Nodes = []struct {
+							Commit struct {
+								Oid    string `json:"oid"`
+								Status *struct {
+									Contexts []commitStatus `json:"contexts"`
+								} `json:"status"`
+							} `json:"commit"`
+						}{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v61 struct {
+						Commit struct {
+							Oid    string `json:"oid"`
+							Status *struct {
+								Contexts []commitStatus `json:"contexts"`
+							} `json:"status"`
+						} `json:"commit"`
+					}
+					easyjson2a877177Decode20(in, &v61)
+					out.Nodes = append(out.Nodes, v61)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal55(out *jwriter.Writer, in pullrequestHeadCommit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix[1:])
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v62, v63 := range in.Nodes {
+				if v62 > 0 {
+					out.RawByte(',')
+				}
+				easyjson2a877177Encode20(out, v63)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestHeadCommit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestHeadCommit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestHeadCommit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestHeadCommit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal55(l, v)
+}
+func easyjson2a877177Decode20(in *jlexer.Lexer, out *struct {
+	Commit struct {
+		Oid    string `json:"oid"`
+		Status *struct {
+			Contexts []commitStatus `json:"contexts"`
+		} `json:"status"`
+	} `json:"commit"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "commit":
+			easyjson2a877177Decode21(in, &out.Commit)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177Encode20(out *jwriter.Writer, in struct {
+	Commit struct {
+		Oid    string `json:"oid"`
+		Status *struct {
+			Contexts []commitStatus `json:"contexts"`
+		} `json:"status"`
+	} `json:"commit"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"commit\":"
+		out.RawString(prefix[1:])
+		easyjson2a877177Encode21(out, in.Commit)
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177Decode21(in *jlexer.Lexer, out *struct {
+	Oid    string `json:"oid"`
+	Status *struct {
+		Contexts []commitStatus `json:"contexts"`
+	} `json:"status"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "oid":
+			out.Oid = string(in.String())
+		case "status":
+			if in.IsNull() {
+				in.Skip()
+				out.Status = nil
+			} else {
+				if out.Status == nil {
+					out.Status = new(struct {
+						Contexts []commitStatus `json:"contexts"`
+					})
+				}
+				easyjson2a877177Decode22(in, out.Status)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177Encode21(out *jwriter.Writer, in struct {
+	Oid    string `json:"oid"`
+	Status *struct {
+		Contexts []commitStatus `json:"contexts"`
+	} `json:"status"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"oid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Oid))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		if in.Status == nil {
+			out.RawString("null")
+		} else {
+			easyjson2a877177Encode22(out, *in.Status)
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177Decode22(in *jlexer.Lexer, out *struct {
+	Contexts []commitStatus `json:"contexts"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "contexts":
+			if in.IsNull() {
+				in.Skip()
+				out.Contexts = nil
+			} else {
+				in.Delim('[')
+				if out.Contexts == nil {
+					if !in.IsDelim(']') {
+						out.Contexts = make([]commitStatus, 0, 1)
+					} else {
+						out.Contexts = []commitStatus{}
+					}
+				} else {
+					out.Contexts = (out.Contexts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v64 commitStatus
+					(v64).UnmarshalEasyJSON(in)
+					out.Contexts = append(out.Contexts, v64)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177Encode22(out *jwriter.Writer, in struct {
+	Contexts []commitStatus `json:"contexts"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"contexts\":"
+		out.RawString(prefix[1:])
+		if in.Contexts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v65, v66 := range in.Contexts {
+				if v65 > 0 {
+					out.RawByte(',')
+				}
+				(v66).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal56(in *jlexer.Lexer, out *pullrequestCommit) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "sha":
+			out.Sha = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		case "authoredDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "additions":
+			out.Additions = int64(in.Int64())
+		case "deletions":
+			out.Deletions = int64(in.Int64())
+		case "url":
+			out.URL = string(in.String())
+		case "author":
+			(out.Author).UnmarshalEasyJSON(in)
+		case "committer":
+			(out.Committer).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal56(out *jwriter.Writer, in pullrequestCommit) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"sha\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Sha))
+	}
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	{
+		const prefix string = ",\"authoredDate\":"
+		out.RawString(prefix)
+		out.Raw((in.Date).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"additions\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Additions))
+	}
+	{
+		const prefix string = ",\"deletions\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Deletions))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		(in.Author).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"committer\":"
+		out.RawString(prefix)
+		(in.Committer).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequestCommit) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequestCommit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequestCommit) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequestCommit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal56(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal57(in *jlexer.Lexer, out *pullrequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "bodyHTML":
+			out.Body = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "closed":
+			out.Closed = bool(in.Bool())
+		case "draft":
+			out.Draft = bool(in.Bool())
+		case "locked":
+			out.Locked = bool(in.Bool())
+		case "merged":
+			out.Merged = bool(in.Bool())
+		case "number":
+			out.Number = int(in.Int())
+		case "state":
+			out.State = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "closedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosedAt = nil
+			} else {
+				if out.ClosedAt == nil {
+					out.ClosedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
+				}
+			}
+		case "mergedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.MergedAt).UnmarshalJSON(data))
+			}
+		case "author":
+			(out.Author).UnmarshalEasyJSON(in)
+		case "branch":
+			out.Branch = string(in.String())
+		case "mergeCommit":
+			(out.MergeCommit).UnmarshalEasyJSON(in)
+		case "mergedBy":
+			(out.MergedBy).UnmarshalEasyJSON(in)
+		case "commits":
+			(out.Commits).UnmarshalEasyJSON(in)
+		case "reviews":
+			(out.Reviews).UnmarshalEasyJSON(in)
+		case "reviewRequests":
+			(out.ReviewRequests).UnmarshalEasyJSON(in)
+		case "comments":
+			(out.Comments).UnmarshalEasyJSON(in)
+		case "timelineItems":
+			(out.TimelineItems).UnmarshalEasyJSON(in)
+		case "headCommit":
+			(out.HeadCommit).UnmarshalEasyJSON(in)
+		case "reviewThreads":
+			(out.ReviewThreads).UnmarshalEasyJSON(in)
+		case "files":
+			(out.Files).UnmarshalEasyJSON(in)
+		case "rawBody":
+			out.RawBody = string(in.String())
+		case "closingIssuesReferences":
+			(out.ClosingIssues).UnmarshalEasyJSON(in)
+		case "labels":
+			easyjson2a877177Decode23(in, &out.Labels)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal57(out *jwriter.Writer, in pullrequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"bodyHTML\":"
+		out.RawString(prefix)
+		out.String(string(in.Body))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Closed))
+	}
+	{
+		const prefix string = ",\"draft\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Draft))
+	}
+	{
+		const prefix string = ",\"locked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Locked))
+	}
+	{
+		const prefix string = ",\"merged\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Merged))
+	}
+	{
+		const prefix string = ",\"number\":"
+		out.RawString(prefix)
+		out.Int(int(in.Number))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"closedAt\":"
+		out.RawString(prefix)
+		if in.ClosedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ClosedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"mergedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.MergedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		(in.Author).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"branch\":"
+		out.RawString(prefix)
+		out.String(string(in.Branch))
+	}
+	{
+		const prefix string = ",\"mergeCommit\":"
+		out.RawString(prefix)
+		(in.MergeCommit).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"mergedBy\":"
+		out.RawString(prefix)
+		(in.MergedBy).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"commits\":"
+		out.RawString(prefix)
+		(in.Commits).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"reviews\":"
+		out.RawString(prefix)
+		(in.Reviews).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"reviewRequests\":"
+		out.RawString(prefix)
+		(in.ReviewRequests).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		(in.Comments).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"timelineItems\":"
+		out.RawString(prefix)
+		(in.TimelineItems).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"headCommit\":"
+		out.RawString(prefix)
+		(in.HeadCommit).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"reviewThreads\":"
+		out.RawString(prefix)
+		(in.ReviewThreads).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"files\":"
+		out.RawString(prefix)
+		(in.Files).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"rawBody\":"
+		out.RawString(prefix)
+		out.String(string(in.RawBody))
+	}
+	{
+		const prefix string = ",\"closingIssuesReferences\":"
+		out.RawString(prefix)
+		(in.ClosingIssues).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode23(out, in.Labels)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pullrequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal57(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pullrequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal57(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pullrequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal57(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pullrequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal57(l, v)
+}
+func easyjson2a877177Decode23(in *jlexer.Lexer, out *struct {
+	Nodes []struct {
+		Name string `json:"name"`
+	} `json:"node"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "node":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]struct {
+							Name string `json:"name"`
+						}, 0, 4)
+					} else {
+						out.Nodes = []struct {
+							Name string `json:"name"`
+						}{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v67 struct {
+						Name string `json:"name"`
+					}
+					easyjson2a877177Decode24(in, &v67)
+					out.Nodes = append(out.Nodes, v67)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177Encode23(out *jwriter.Writer, in struct {
+	Nodes []struct {
+		Name string `json:"name"`
+	} `json:"node"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"node\":"
+		out.RawString(prefix[1:])
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v68, v69 := range in.Nodes {
+				if v68 > 0 {
+					out.RawByte(',')
+				}
+				easyjson2a877177Encode24(out, v69)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177Decode24(in *jlexer.Lexer, out *struct {
+	Name string `json:"name"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177Encode24(out *jwriter.Writer, in struct {
+	Name string `json:"name"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal58(in *jlexer.Lexer, out *pageInfo) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "hasNextPage":
+			out.HasNextPage = bool(in.Bool())
+		case "hasPreviousPage":
+			out.HasPreviousPage = bool(in.Bool())
+		case "startCursor":
+			out.StartCursor = string(in.String())
+		case "endCursor":
+			out.EndCursor = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal58(out *jwriter.Writer, in pageInfo) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"hasNextPage\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.HasNextPage))
+	}
+	{
+		const prefix string = ",\"hasPreviousPage\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasPreviousPage))
+	}
+	{
+		const prefix string = ",\"startCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.StartCursor))
+	}
+	{
+		const prefix string = ",\"endCursor\":"
+		out.RawString(prefix)
+		out.String(string(in.EndCursor))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v pageInfo) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal58(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v pageInfo) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal58(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *pageInfo) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal58(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *pageInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal58(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal59(in *jlexer.Lexer, out *organizations) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]*org, 0, 8)
+					} else {
+						out.Nodes = []*org{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v70 *org
+					if in.IsNull() {
+						in.Skip()
+						v70 = nil
+					} else {
+						if v70 == nil {
+							v70 = new(org)
+						}
+						(*v70).UnmarshalEasyJSON(in)
+					}
+					out.Nodes = append(out.Nodes, v70)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal59(out *jwriter.Writer, in organizations) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix[1:])
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v71, v72 := range in.Nodes {
+				if v71 > 0 {
+					out.RawByte(',')
+				}
+				if v72 == nil {
+					out.RawString("null")
+				} else {
+					(*v72).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v organizations) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v organizations) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *organizations) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *organizations) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal59(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal60(in *jlexer.Lexer, out *org) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "login":
+			out.Login = string(in.String())
+		case "avatarUrl":
+			out.AvatarURL = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "viewerIsAMember":
+			out.IsMember = bool(in.Bool())
+		case "viewerCanAdminister":
+			out.IsAdmin = bool(in.Bool())
+		case "repositories":
+			easyjson2a877177Decode1(in, &out.Repositories)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal60(out *jwriter.Writer, in org) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix)
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"avatarUrl\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarURL))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"viewerIsAMember\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMember))
+	}
+	{
+		const prefix string = ",\"viewerCanAdminister\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsAdmin))
+	}
+	{
+		const prefix string = ",\"repositories\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode1(out, in.Repositories)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v org) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v org) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *org) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *org) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal60(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal61(in *jlexer.Lexer, out *oidProp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "oid":
+			out.Oid = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal61(out *jwriter.Writer, in oidProp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"oid\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Oid))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v oidProp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal61(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v oidProp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal61(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *oidProp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal61(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *oidProp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal61(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal62(in *jlexer.Lexer, out *nameProp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal62(out *jwriter.Writer, in nameProp) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v nameProp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal62(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v nameProp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal62(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *nameProp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal62(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *nameProp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal62(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal63(in *jlexer.Lexer, out *mutationResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "clientMutationId":
+			out.ID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal63(out *jwriter.Writer, in mutationResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"clientMutationId\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v mutationResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal63(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v mutationResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal63(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *mutationResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal63(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *mutationResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal63(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal64(in *jlexer.Lexer, out *milestoneRest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "creator":
+			(out.Creator).UnmarshalEasyJSON(in)
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "number":
+			out.Number = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "html_url":
+			out.HTMLUrl = string(in.String())
+		case "closed":
+			out.Closed = bool(in.Bool())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "closedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosedAt = nil
+			} else {
+				if out.ClosedAt == nil {
+					out.ClosedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
+				}
+			}
+		case "dueOn":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "state":
+			out.State = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal64(out *jwriter.Writer, in milestoneRest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"creator\":"
+		out.RawString(prefix)
+		(in.Creator).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"number\":"
+		out.RawString(prefix)
+		out.Int(int(in.Number))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"html_url\":"
+		out.RawString(prefix)
+		out.String(string(in.HTMLUrl))
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Closed))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"closedAt\":"
+		out.RawString(prefix)
+		if in.ClosedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ClosedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"dueOn\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v milestoneRest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal64(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v milestoneRest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal64(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *milestoneRest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal64(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *milestoneRest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal64(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal65(in *jlexer.Lexer, out *milestoneNodes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]milestone, 0, 1)
+					} else {
+						out.Nodes = []milestone{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 milestone
+					(v73).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal65(out *jwriter.Writer, in milestoneNodes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix[1:])
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v74, v75 := range in.Nodes {
+				if v74 > 0 {
+					out.RawByte(',')
+				}
+				(v75).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v milestoneNodes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal65(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v milestoneNodes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal65(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *milestoneNodes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal65(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *milestoneNodes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal65(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal66(in *jlexer.Lexer, out *milestoneCommon) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "number":
+			out.Number = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "html_url":
+			out.HTMLUrl = string(in.String())
+		case "closed":
+			out.Closed = bool(in.Bool())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "closedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosedAt = nil
+			} else {
+				if out.ClosedAt == nil {
+					out.ClosedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
+				}
+			}
+		case "dueOn":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "state":
+			out.State = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal66(out *jwriter.Writer, in milestoneCommon) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"number\":"
+		out.RawString(prefix)
+		out.Int(int(in.Number))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"html_url\":"
+		out.RawString(prefix)
+		out.String(string(in.HTMLUrl))
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Closed))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"closedAt\":"
+		out.RawString(prefix)
+		if in.ClosedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ClosedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"dueOn\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v milestoneCommon) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal66(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v milestoneCommon) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal66(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *milestoneCommon) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal66(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *milestoneCommon) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal66(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal67(in *jlexer.Lexer, out *milestone) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "creator":
+			(out.Creator).UnmarshalEasyJSON(in)
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "number":
+			out.Number = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "html_url":
+			out.HTMLUrl = string(in.String())
+		case "closed":
+			out.Closed = bool(in.Bool())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "closedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosedAt = nil
+			} else {
+				if out.ClosedAt == nil {
+					out.ClosedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
+				}
+			}
+		case "dueOn":
+			if in.IsNull() {
+				in.Skip()
+				out.DueAt = nil
+			} else {
+				if out.DueAt == nil {
+					out.DueAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				}
+			}
+		case "state":
+			out.State = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal67(out *jwriter.Writer, in milestone) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"creator\":"
+		out.RawString(prefix)
+		(in.Creator).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"number\":"
+		out.RawString(prefix)
+		out.Int(int(in.Number))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"html_url\":"
+		out.RawString(prefix)
+		out.String(string(in.HTMLUrl))
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Closed))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"closedAt\":"
+		out.RawString(prefix)
+		if in.ClosedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ClosedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"dueOn\":"
+		out.RawString(prefix)
+		if in.DueAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.DueAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v milestone) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal67(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v milestone) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal67(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *milestone) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal67(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *milestone) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal67(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal68(in *jlexer.Lexer, out *labelNode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]label, 0, 1)
+					} else {
+						out.Nodes = []label{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v76 label
+					(v76).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v76)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal68(out *jwriter.Writer, in labelNode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix[1:])
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v77, v78 := range in.Nodes {
+				if v77 > 0 {
+					out.RawByte(',')
+				}
+				(v78).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v labelNode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal68(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v labelNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal68(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *labelNode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal68(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *labelNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal68(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal69(in *jlexer.Lexer, out *label) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal69(out *jwriter.Writer, in label) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v label) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal69(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v label) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal69(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *label) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal69(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *label) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal69(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal70(in *jlexer.Lexer, out *issueUpdateResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "data":
+			easyjson2a877177Decode25(in, &out.Data)
+		case "errors":
+			if in.IsNull() {
+				in.Skip()
+				out.Errors = nil
+			} else {
+				in.Delim('[')
+				if out.Errors == nil {
+					if !in.IsDelim(']') {
+						out.Errors = make([]struct {
+							Message string `json:"message"`
+						}, 0, 4)
+					} else {
+						out.Errors = []struct {
+							Message string `json:"message"`
+						}{}
+					}
+				} else {
+					out.Errors = (out.Errors)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 struct {
+						Message string `json:"message"`
+					}
+					easyjson2a877177Decode26(in, &v79)
+					out.Errors = append(out.Errors, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal70(out *jwriter.Writer, in issueUpdateResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"data\":"
+		out.RawString(prefix[1:])
+		easyjson2a877177Encode25(out, in.Data)
+	}
+	{
+		const prefix string = ",\"errors\":"
+		out.RawString(prefix)
+		if in.Errors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v80, v81 := range in.Errors {
+				if v80 > 0 {
+					out.RawByte(',')
+				}
+				easyjson2a877177Encode26(out, v81)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issueUpdateResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal70(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issueUpdateResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal70(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issueUpdateResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal70(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issueUpdateResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal70(l, v)
+}
+func easyjson2a877177Decode26(in *jlexer.Lexer, out *struct {
+	Message string `json:"message"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177Encode26(out *jwriter.Writer, in struct {
+	Message string `json:"message"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177Decode25(in *jlexer.Lexer, out *struct {
+	CreateIssue struct {
+		Issue CreateIssue `json:"issue"`
+	} `json:"updateIssue"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "updateIssue":
+			easyjson2a877177Decode27(in, &out.CreateIssue)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177Encode25(out *jwriter.Writer, in struct {
+	CreateIssue struct {
+		Issue CreateIssue `json:"issue"`
+	} `json:"updateIssue"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"updateIssue\":"
+		out.RawString(prefix[1:])
+		easyjson2a877177Encode27(out, in.CreateIssue)
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177Decode27(in *jlexer.Lexer, out *struct {
+	Issue CreateIssue `json:"issue"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "issue":
+			(out.Issue).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177Encode27(out *jwriter.Writer, in struct {
+	Issue CreateIssue `json:"issue"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"issue\":"
+		out.RawString(prefix[1:])
+		(in.Issue).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal71(in *jlexer.Lexer, out *issueTypeRule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "label":
+			out.Label = string(in.String())
+		case "pattern":
+			out.Pattern = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal71(out *jwriter.Writer, in issueTypeRule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"pattern\":"
+		out.RawString(prefix)
+		out.String(string(in.Pattern))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issueTypeRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal71(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issueTypeRule) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal71(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issueTypeRule) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal71(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issueTypeRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal71(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal72(in *jlexer.Lexer, out *issueTimelineItems) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]issueTimelineItem, 0, 1)
+					} else {
+						out.Nodes = []issueTimelineItem{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v82 issueTimelineItem
+					(v82).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v82)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal72(out *jwriter.Writer, in issueTimelineItems) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix[1:])
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v83, v84 := range in.Nodes {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				(v84).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issueTimelineItems) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal72(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issueTimelineItems) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal72(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issueTimelineItems) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal72(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issueTimelineItems) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal72(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal73(in *jlexer.Lexer, out *issueTimelineItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "actor":
+			if in.IsNull() {
+				in.Skip()
+				out.Actor = nil
+			} else {
+				if out.Actor == nil {
+					out.Actor = new(author)
+				}
+				(*out.Actor).UnmarshalEasyJSON(in)
+			}
+		case "label":
+			if in.IsNull() {
+				in.Skip()
+				out.Label = nil
+			} else {
+				if out.Label == nil {
+					out.Label = new(nameProp)
+				}
+				(*out.Label).UnmarshalEasyJSON(in)
+			}
+		case "assignee":
+			if in.IsNull() {
+				in.Skip()
+				out.Assignee = nil
+			} else {
+				if out.Assignee == nil {
+					out.Assignee = new(author)
+				}
+				(*out.Assignee).UnmarshalEasyJSON(in)
+			}
+		case "milestoneTitle":
+			out.MilestoneTitle = string(in.String())
+		case "previousTitle":
+			out.PreviousTitle = string(in.String())
+		case "currentTitle":
+			out.CurrentTitle = string(in.String())
+		case "previousProjectColumnName":
+			out.PreviousProjectColumnName = string(in.String())
+		case "projectColumnName":
+			out.ProjectColumnName = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal73(out *jwriter.Writer, in issueTimelineItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"actor\":"
+		out.RawString(prefix)
+		if in.Actor == nil {
+			out.RawString("null")
+		} else {
+			(*in.Actor).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix)
+		if in.Label == nil {
+			out.RawString("null")
+		} else {
+			(*in.Label).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"assignee\":"
+		out.RawString(prefix)
+		if in.Assignee == nil {
+			out.RawString("null")
+		} else {
+			(*in.Assignee).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"milestoneTitle\":"
+		out.RawString(prefix)
+		out.String(string(in.MilestoneTitle))
+	}
+	{
+		const prefix string = ",\"previousTitle\":"
+		out.RawString(prefix)
+		out.String(string(in.PreviousTitle))
+	}
+	{
+		const prefix string = ",\"currentTitle\":"
+		out.RawString(prefix)
+		out.String(string(in.CurrentTitle))
+	}
+	{
+		const prefix string = ",\"previousProjectColumnName\":"
+		out.RawString(prefix)
+		out.String(string(in.PreviousProjectColumnName))
+	}
+	{
+		const prefix string = ",\"projectColumnName\":"
+		out.RawString(prefix)
+		out.String(string(in.ProjectColumnName))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issueTimelineItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal73(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issueTimelineItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal73(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issueTimelineItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal73(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issueTimelineItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal73(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal74(in *jlexer.Lexer, out *issueResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rateLimit":
+			(out.RateLimit).UnmarshalEasyJSON(in)
+		case "repository":
+			(out.Repository).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal74(out *jwriter.Writer, in issueResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rateLimit\":"
+		out.RawString(prefix[1:])
+		(in.RateLimit).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"repository\":"
+		out.RawString(prefix)
+		(in.Repository).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issueResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal74(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issueResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal74(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issueResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal74(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issueResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal74(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal75(in *jlexer.Lexer, out *issueResolution) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "refID":
+			out.RefID = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal75(out *jwriter.Writer, in issueResolution) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"refID\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RefID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issueResolution) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal75(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issueResolution) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal75(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issueResolution) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal75(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issueResolution) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal75(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal76(in *jlexer.Lexer, out *issueRepository) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "issues":
+			(out.Issues).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal76(out *jwriter.Writer, in issueRepository) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"issues\":"
+		out.RawString(prefix[1:])
+		(in.Issues).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issueRepository) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal76(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issueRepository) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal76(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issueRepository) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal76(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issueRepository) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal76(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal77(in *jlexer.Lexer, out *issueReferences) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]issueReference, 0, 1)
+					} else {
+						out.Nodes = []issueReference{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v85 issueReference
+					(v85).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v85)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal77(out *jwriter.Writer, in issueReferences) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix[1:])
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v86, v87 := range in.Nodes {
+				if v86 > 0 {
+					out.RawByte(',')
+				}
+				(v87).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issueReferences) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal77(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issueReferences) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal77(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issueReferences) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal77(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issueReferences) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal77(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal78(in *jlexer.Lexer, out *issueReference) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "number":
+			out.Number = int(in.Int())
+		case "url":
+			out.URL = string(in.String())
+		case "repository":
+			easyjson2a877177Decode28(in, &out.Repository)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal78(out *jwriter.Writer, in issueReference) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"number\":"
+		out.RawString(prefix)
+		out.Int(int(in.Number))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"repository\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode28(out, in.Repository)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issueReference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal78(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issueReference) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal78(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issueReference) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal78(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issueReference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal78(l, v)
+}
+func easyjson2a877177Decode28(in *jlexer.Lexer, out *struct {
+	ID            string `json:"id"`
+	NameWithOwner string `json:"nameWithOwner"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "nameWithOwner":
+			out.NameWithOwner = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177Encode28(out *jwriter.Writer, in struct {
+	ID            string `json:"id"`
+	NameWithOwner string `json:"nameWithOwner"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"nameWithOwner\":"
+		out.RawString(prefix)
+		out.String(string(in.NameWithOwner))
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal79(in *jlexer.Lexer, out *issuePriorityRule) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "label":
+			out.Label = string(in.String())
+		case "pattern":
+			out.Pattern = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal79(out *jwriter.Writer, in issuePriorityRule) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"label\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Label))
+	}
+	{
+		const prefix string = ",\"pattern\":"
+		out.RawString(prefix)
+		out.String(string(in.Pattern))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issuePriorityRule) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal79(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issuePriorityRule) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal79(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issuePriorityRule) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal79(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issuePriorityRule) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal79(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal80(in *jlexer.Lexer, out *issueNode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "totalCount":
+			out.TotalCount = int(in.Int())
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Nodes = nil
+			} else {
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]issue, 0, 1)
+					} else {
+						out.Nodes = []issue{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v88 issue
+					(v88).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v88)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal80(out *jwriter.Writer, in issueNode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"totalCount\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalCount))
+	}
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix)
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v89, v90 := range in.Nodes {
+				if v89 > 0 {
+					out.RawByte(',')
+				}
+				(v90).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issueNode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal80(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issueNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal80(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issueNode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal80(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issueNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal80(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal81(in *jlexer.Lexer, out *issueMilestone) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal81(out *jwriter.Writer, in issueMilestone) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issueMilestone) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal81(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issueMilestone) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal81(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issueMilestone) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal81(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issueMilestone) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal81(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal82(in *jlexer.Lexer, out *issue) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "closedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ClosedAt = nil
+			} else {
+				if out.ClosedAt == nil {
+					out.ClosedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
+				}
+			}
+		case "state":
+			out.State = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "body":
+			out.Body = string(in.String())
+		case "closed":
+			out.Closed = bool(in.Bool())
+		case "labels":
+			(out.Labels).UnmarshalEasyJSON(in)
+		case "comments":
+			(out.Comments).UnmarshalEasyJSON(in)
+		case "assignees":
+			(out.Assignees).UnmarshalEasyJSON(in)
+		case "author":
+			(out.Author).UnmarshalEasyJSON(in)
+		case "number":
+			out.Number = int(in.Int())
+		case "milestone":
+			if in.IsNull() {
+				in.Skip()
+				out.Milestone = nil
+			} else {
+				if out.Milestone == nil {
+					out.Milestone = new(issueMilestone)
+				}
+				(*out.Milestone).UnmarshalEasyJSON(in)
+			}
+		case "closedByPullRequestsReferences":
+			(out.ClosedBy).UnmarshalEasyJSON(in)
+		case "timelineItems":
+			(out.Timeline).UnmarshalEasyJSON(in)
+		case "stateReason":
+			out.Reason = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal82(out *jwriter.Writer, in issue) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4196,145 +10160,405 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal41(out *jwriter.Writer, i
 		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"bodyHTML\":"
+		const prefix string = ",\"createdAt\":"
 		out.RawString(prefix)
-		out.String(string(in.Body))
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"closedAt\":"
+		out.RawString(prefix)
+		if in.ClosedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ClosedAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
 	}
 	{
 		const prefix string = ",\"url\":"
 		out.RawString(prefix)
 		out.String(string(in.URL))
 	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"body\":"
+		out.RawString(prefix)
+		out.String(string(in.Body))
+	}
 	{
 		const prefix string = ",\"closed\":"
 		out.RawString(prefix)
 		out.Bool(bool(in.Closed))
 	}
 	{
-		const prefix string = ",\"draft\":"
+		const prefix string = ",\"labels\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Draft))
+		(in.Labels).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"locked\":"
+		const prefix string = ",\"comments\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Locked))
+		(in.Comments).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"merged\":"
+		const prefix string = ",\"assignees\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.Merged))
+		(in.Assignees).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		(in.Author).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"number\":"
 		out.RawString(prefix)
 		out.Int(int(in.Number))
 	}
-	{
-		const prefix string = ",\"state\":"
-		out.RawString(prefix)
-		out.String(string(in.State))
+	{
+		const prefix string = ",\"milestone\":"
+		out.RawString(prefix)
+		if in.Milestone == nil {
+			out.RawString("null")
+		} else {
+			(*in.Milestone).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"closedByPullRequestsReferences\":"
+		out.RawString(prefix)
+		(in.ClosedBy).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"timelineItems\":"
+		out.RawString(prefix)
+		(in.Timeline).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"stateReason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v issue) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal82(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v issue) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal82(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *issue) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal82(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *issue) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal82(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal83(in *jlexer.Lexer, out *idProp) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal83(out *jwriter.Writer, in idProp) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
 	}
-	{
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v idProp) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal83(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v idProp) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal83(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *idProp) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal83(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *idProp) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal83(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal84(in *jlexer.Lexer, out *gitUser) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"mergedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.MergedAt).MarshalJSON())
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		case "avatarUrl":
+			out.Avatar = string(in.String())
+		case "user":
+			(out.User).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"author\":"
-		out.RawString(prefix)
-		(in.Author).MarshalEasyJSON(out)
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal84(out *jwriter.Writer, in gitUser) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"branch\":"
-		out.RawString(prefix)
-		out.String(string(in.Branch))
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"mergeCommit\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix)
-		(in.MergeCommit).MarshalEasyJSON(out)
+		out.String(string(in.Email))
 	}
 	{
-		const prefix string = ",\"mergedBy\":"
+		const prefix string = ",\"avatarUrl\":"
 		out.RawString(prefix)
-		(in.MergedBy).MarshalEasyJSON(out)
+		out.String(string(in.Avatar))
 	}
 	{
-		const prefix string = ",\"commits\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix)
-		(in.Commits).MarshalEasyJSON(out)
+		(in.User).MarshalEasyJSON(out)
 	}
-	{
-		const prefix string = ",\"reviews\":"
-		out.RawString(prefix)
-		(in.Reviews).MarshalEasyJSON(out)
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v gitUser) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal84(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v gitUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal84(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *gitUser) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal84(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *gitUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal84(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal85(in *jlexer.Lexer, out *deploymentsResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
 	}
-	{
-		const prefix string = ",\"reviewRequests\":"
-		out.RawString(prefix)
-		(in.ReviewRequests).MarshalEasyJSON(out)
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rateLimit":
+			(out.RateLimit).UnmarshalEasyJSON(in)
+		case "repository":
+			easyjson2a877177Decode29(in, &out.Repository)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"comments\":"
-		out.RawString(prefix)
-		(in.Comments).MarshalEasyJSON(out)
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal85(out *jwriter.Writer, in deploymentsResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"timelineItems\":"
-		out.RawString(prefix)
-		(in.TimelineItems).MarshalEasyJSON(out)
+		const prefix string = ",\"rateLimit\":"
+		out.RawString(prefix[1:])
+		(in.RateLimit).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"labels\":"
+		const prefix string = ",\"repository\":"
 		out.RawString(prefix)
-		easyjson2a877177Encode8(out, in.Labels)
+		easyjson2a877177Encode29(out, in.Repository)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pullrequest) MarshalJSON() ([]byte, error) {
+func (v deploymentsResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal41(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal85(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pullrequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal41(w, v)
+func (v deploymentsResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal85(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pullrequest) UnmarshalJSON(data []byte) error {
+func (v *deploymentsResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal41(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal85(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pullrequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal41(l, v)
+func (v *deploymentsResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal85(l, v)
+}
+func easyjson2a877177Decode29(in *jlexer.Lexer, out *struct {
+	Deployments struct {
+		PageInfo pageInfo     `json:"pageInfo"`
+		Nodes    []deployment `json:"nodes"`
+	} `json:"deployments"`
+}) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "deployments":
+			easyjson2a877177Decode30(in, &out.Deployments)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
 }
-func easyjson2a877177Decode8(in *jlexer.Lexer, out *struct {
-	Nodes []struct {
-		Name string `json:"name"`
-	} `json:"node"`
+func easyjson2a877177Encode29(out *jwriter.Writer, in struct {
+	Deployments struct {
+		PageInfo pageInfo     `json:"pageInfo"`
+		Nodes    []deployment `json:"nodes"`
+	} `json:"deployments"`
+}) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"deployments\":"
+		out.RawString(prefix[1:])
+		easyjson2a877177Encode30(out, in.Deployments)
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177Decode30(in *jlexer.Lexer, out *struct {
+	PageInfo pageInfo     `json:"pageInfo"`
+	Nodes    []deployment `json:"nodes"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -4354,7 +10578,9 @@ func easyjson2a877177Decode8(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "node":
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
 			if in.IsNull() {
 				in.Skip()
 				out.Nodes = nil
@@ -4362,23 +10588,17 @@ func easyjson2a877177Decode8(in *jlexer.Lexer, out *struct {
 				in.Delim('[')
 				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.Nodes = make([]struct {
-							Name string `json:"name"`
-						}, 0, 4)
+						out.Nodes = make([]deployment, 0, 1)
 					} else {
-						out.Nodes = []struct {
-							Name string `json:"name"`
-						}{}
+						out.Nodes = []deployment{}
 					}
 				} else {
 					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v37 struct {
-						Name string `json:"name"`
-					}
-					easyjson2a877177Decode9(in, &v37)
-					out.Nodes = append(out.Nodes, v37)
+					var v91 deployment
+					(v91).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v91)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4393,35 +10613,37 @@ func easyjson2a877177Decode8(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjson2a877177Encode8(out *jwriter.Writer, in struct {
-	Nodes []struct {
-		Name string `json:"name"`
-	} `json:"node"`
+func easyjson2a877177Encode30(out *jwriter.Writer, in struct {
+	PageInfo pageInfo     `json:"pageInfo"`
+	Nodes    []deployment `json:"nodes"`
 }) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"node\":"
+		const prefix string = ",\"pageInfo\":"
 		out.RawString(prefix[1:])
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
 		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v38, v39 := range in.Nodes {
-				if v38 > 0 {
+			for v92, v93 := range in.Nodes {
+				if v92 > 0 {
 					out.RawByte(',')
 				}
-				easyjson2a877177Encode9(out, v39)
+				(v93).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-func easyjson2a877177Decode9(in *jlexer.Lexer, out *struct {
-	Name string `json:"name"`
-}) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal86(in *jlexer.Lexer, out *deploymentStatus) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4440,8 +10662,28 @@ func easyjson2a877177Decode9(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
+		case "state":
+			out.State = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "logUrl":
+			out.LogURL = string(in.String())
+		case "environmentUrl":
+			out.EnvironmentURL = string(in.String())
+		case "creator":
+			if in.IsNull() {
+				in.Skip()
+				out.Creator = nil
+			} else {
+				if out.Creator == nil {
+					out.Creator = new(author)
+				}
+				(*out.Creator).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4452,20 +10694,71 @@ func easyjson2a877177Decode9(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjson2a877177Encode9(out *jwriter.Writer, in struct {
-	Name string `json:"name"`
-}) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal86(out *jwriter.Writer, in deploymentStatus) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"state\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"logUrl\":"
+		out.RawString(prefix)
+		out.String(string(in.LogURL))
+	}
+	{
+		const prefix string = ",\"environmentUrl\":"
+		out.RawString(prefix)
+		out.String(string(in.EnvironmentURL))
+	}
+	{
+		const prefix string = ",\"creator\":"
+		out.RawString(prefix)
+		if in.Creator == nil {
+			out.RawString("null")
+		} else {
+			(*in.Creator).MarshalEasyJSON(out)
+		}
 	}
 	out.RawByte('}')
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal42(in *jlexer.Lexer, out *pageInfo) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v deploymentStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal86(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v deploymentStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal86(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *deploymentStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal86(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *deploymentStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal86(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal87(in *jlexer.Lexer, out *deployment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4484,12 +10777,48 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal42(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "hasNextPage":
-			out.HasNextPage = bool(in.Bool())
-		case "startCursor":
-			out.StartCursor = string(in.String())
-		case "endCursor":
-			out.EndCursor = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "environment":
+			out.Environment = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "state":
+			out.State = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "commitOid":
+			out.CommitOid = string(in.String())
+		case "ref":
+			if in.IsNull() {
+				in.Skip()
+				out.Ref = nil
+			} else {
+				if out.Ref == nil {
+					out.Ref = new(struct {
+						Name string `json:"name"`
+					})
+				}
+				easyjson2a877177Decode24(in, out.Ref)
+			}
+		case "creator":
+			if in.IsNull() {
+				in.Skip()
+				out.Creator = nil
+			} else {
+				if out.Creator == nil {
+					out.Creator = new(author)
+				}
+				(*out.Creator).UnmarshalEasyJSON(in)
+			}
+		case "statuses":
+			easyjson2a877177Decode31(in, &out.Statuses)
 		default:
 			in.SkipRecursive()
 		}
@@ -4500,52 +10829,97 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal42(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal42(out *jwriter.Writer, in pageInfo) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal87(out *jwriter.Writer, in deployment) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"hasNextPage\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.HasNextPage))
+		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"startCursor\":"
+		const prefix string = ",\"environment\":"
 		out.RawString(prefix)
-		out.String(string(in.StartCursor))
+		out.String(string(in.Environment))
 	}
 	{
-		const prefix string = ",\"endCursor\":"
+		const prefix string = ",\"description\":"
 		out.RawString(prefix)
-		out.String(string(in.EndCursor))
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"commitOid\":"
+		out.RawString(prefix)
+		out.String(string(in.CommitOid))
+	}
+	{
+		const prefix string = ",\"ref\":"
+		out.RawString(prefix)
+		if in.Ref == nil {
+			out.RawString("null")
+		} else {
+			easyjson2a877177Encode24(out, *in.Ref)
+		}
+	}
+	{
+		const prefix string = ",\"creator\":"
+		out.RawString(prefix)
+		if in.Creator == nil {
+			out.RawString("null")
+		} else {
+			(*in.Creator).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"statuses\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode31(out, in.Statuses)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v pageInfo) MarshalJSON() ([]byte, error) {
+func (v deployment) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal42(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal87(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v pageInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal42(w, v)
+func (v deployment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal87(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *pageInfo) UnmarshalJSON(data []byte) error {
+func (v *deployment) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal42(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal87(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *pageInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal42(l, v)
+func (v *deployment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal87(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal43(in *jlexer.Lexer, out *organizations) {
+func easyjson2a877177Decode31(in *jlexer.Lexer, out *struct {
+	Nodes []deploymentStatus `json:"nodes"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4572,25 +10946,17 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal43(in *jlexer.Lexer, out
 				in.Delim('[')
 				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.Nodes = make([]*org, 0, 8)
+						out.Nodes = make([]deploymentStatus, 0, 1)
 					} else {
-						out.Nodes = []*org{}
+						out.Nodes = []deploymentStatus{}
 					}
 				} else {
 					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v40 *org
-					if in.IsNull() {
-						in.Skip()
-						v40 = nil
-					} else {
-						if v40 == nil {
-							v40 = new(org)
-						}
-						(*v40).UnmarshalEasyJSON(in)
-					}
-					out.Nodes = append(out.Nodes, v40)
+					var v94 deploymentStatus
+					(v94).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v94)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4605,7 +10971,9 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal43(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal43(out *jwriter.Writer, in organizations) {
+func easyjson2a877177Encode31(out *jwriter.Writer, in struct {
+	Nodes []deploymentStatus `json:"nodes"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4616,46 +10984,18 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal43(out *jwriter.Writer, i
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v41, v42 := range in.Nodes {
-				if v41 > 0 {
+			for v95, v96 := range in.Nodes {
+				if v95 > 0 {
 					out.RawByte(',')
 				}
-				if v42 == nil {
-					out.RawString("null")
-				} else {
-					(*v42).MarshalEasyJSON(out)
-				}
+				(v96).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v organizations) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal43(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v organizations) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal43(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *organizations) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal43(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *organizations) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal43(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal44(in *jlexer.Lexer, out *org) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal88(in *jlexer.Lexer, out *defaultBranchCommitsResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4674,22 +11014,10 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal44(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "login":
-			out.Login = string(in.String())
-		case "avatarUrl":
-			out.AvatarURL = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "viewerIsAMember":
-			out.IsMember = bool(in.Bool())
-		case "viewerCanAdminister":
-			out.IsAdmin = bool(in.Bool())
-		case "repositories":
-			easyjson2a877177Decode1(in, &out.Repositories)
+		case "rateLimit":
+			(out.RateLimit).UnmarshalEasyJSON(in)
+		case "repository":
+			easyjson2a877177Decode32(in, &out.Repository)
 		default:
 			in.SkipRecursive()
 		}
@@ -4700,77 +11028,58 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal44(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal44(out *jwriter.Writer, in org) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal88(out *jwriter.Writer, in defaultBranchCommitsResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"name\":"
-		out.RawString(prefix)
-		out.String(string(in.Name))
-	}
-	{
-		const prefix string = ",\"login\":"
-		out.RawString(prefix)
-		out.String(string(in.Login))
-	}
-	{
-		const prefix string = ",\"avatarUrl\":"
-		out.RawString(prefix)
-		out.String(string(in.AvatarURL))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"viewerIsAMember\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsMember))
-	}
-	{
-		const prefix string = ",\"viewerCanAdminister\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsAdmin))
+		const prefix string = ",\"rateLimit\":"
+		out.RawString(prefix[1:])
+		(in.RateLimit).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"repositories\":"
+		const prefix string = ",\"repository\":"
 		out.RawString(prefix)
-		easyjson2a877177Encode1(out, in.Repositories)
+		easyjson2a877177Encode32(out, in.Repository)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v org) MarshalJSON() ([]byte, error) {
+func (v defaultBranchCommitsResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal44(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal88(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v org) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal44(w, v)
+func (v defaultBranchCommitsResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal88(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *org) UnmarshalJSON(data []byte) error {
+func (v *defaultBranchCommitsResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal44(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal88(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *org) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal44(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal45(in *jlexer.Lexer, out *oidProp) {
+func (v *defaultBranchCommitsResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal88(l, v)
+}
+func easyjson2a877177Decode32(in *jlexer.Lexer, out *struct {
+	DefaultBranchRef *struct {
+		Name   string `json:"name"`
+		Target struct {
+			History struct {
+				TotalCount int      `json:"totalCount"`
+				PageInfo   pageInfo `json:"pageInfo"`
+				Nodes      []commit `json:"nodes"`
+			} `json:"history"`
+		} `json:"target"`
+	} `json:"defaultBranchRef"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4789,8 +11098,25 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal45(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "oid":
-			out.Oid = string(in.String())
+		case "defaultBranchRef":
+			if in.IsNull() {
+				in.Skip()
+				out.DefaultBranchRef = nil
+			} else {
+				if out.DefaultBranchRef == nil {
+					out.DefaultBranchRef = new(struct {
+						Name   string `json:"name"`
+						Target struct {
+							History struct {
+								TotalCount int      `json:"totalCount"`
+								PageInfo   pageInfo `json:"pageInfo"`
+								Nodes      []commit `json:"nodes"`
+							} `json:"history"`
+						} `json:"target"`
+					})
+				}
+				easyjson2a877177Decode33(in, out.DefaultBranchRef)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -4801,42 +11127,42 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal45(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal45(out *jwriter.Writer, in oidProp) {
+func easyjson2a877177Encode32(out *jwriter.Writer, in struct {
+	DefaultBranchRef *struct {
+		Name   string `json:"name"`
+		Target struct {
+			History struct {
+				TotalCount int      `json:"totalCount"`
+				PageInfo   pageInfo `json:"pageInfo"`
+				Nodes      []commit `json:"nodes"`
+			} `json:"history"`
+		} `json:"target"`
+	} `json:"defaultBranchRef"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"oid\":"
+		const prefix string = ",\"defaultBranchRef\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Oid))
+		if in.DefaultBranchRef == nil {
+			out.RawString("null")
+		} else {
+			easyjson2a877177Encode33(out, *in.DefaultBranchRef)
+		}
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v oidProp) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal45(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v oidProp) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal45(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *oidProp) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal45(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *oidProp) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal45(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal46(in *jlexer.Lexer, out *nameProp) {
+func easyjson2a877177Decode33(in *jlexer.Lexer, out *struct {
+	Name   string `json:"name"`
+	Target struct {
+		History struct {
+			TotalCount int      `json:"totalCount"`
+			PageInfo   pageInfo `json:"pageInfo"`
+			Nodes      []commit `json:"nodes"`
+		} `json:"history"`
+	} `json:"target"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4857,6 +11183,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal46(in *jlexer.Lexer, out
 		switch key {
 		case "name":
 			out.Name = string(in.String())
+		case "target":
+			easyjson2a877177Decode34(in, &out.Target)
 		default:
 			in.SkipRecursive()
 		}
@@ -4867,7 +11195,16 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal46(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal46(out *jwriter.Writer, in nameProp) {
+func easyjson2a877177Encode33(out *jwriter.Writer, in struct {
+	Name   string `json:"name"`
+	Target struct {
+		History struct {
+			TotalCount int      `json:"totalCount"`
+			PageInfo   pageInfo `json:"pageInfo"`
+			Nodes      []commit `json:"nodes"`
+		} `json:"history"`
+	} `json:"target"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4876,33 +11213,20 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal46(out *jwriter.Writer, i
 		out.RawString(prefix[1:])
 		out.String(string(in.Name))
 	}
+	{
+		const prefix string = ",\"target\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode34(out, in.Target)
+	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v nameProp) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal46(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v nameProp) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal46(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *nameProp) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal46(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *nameProp) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal46(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal47(in *jlexer.Lexer, out *mutationResponse) {
+func easyjson2a877177Decode34(in *jlexer.Lexer, out *struct {
+	History struct {
+		TotalCount int      `json:"totalCount"`
+		PageInfo   pageInfo `json:"pageInfo"`
+		Nodes      []commit `json:"nodes"`
+	} `json:"history"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4921,8 +11245,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal47(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "clientMutationId":
-			out.ID = int(in.Int())
+		case "history":
+			easyjson2a877177Decode35(in, &out.History)
 		default:
 			in.SkipRecursive()
 		}
@@ -4933,42 +11257,28 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal47(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal47(out *jwriter.Writer, in mutationResponse) {
+func easyjson2a877177Encode34(out *jwriter.Writer, in struct {
+	History struct {
+		TotalCount int      `json:"totalCount"`
+		PageInfo   pageInfo `json:"pageInfo"`
+		Nodes      []commit `json:"nodes"`
+	} `json:"history"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"clientMutationId\":"
+		const prefix string = ",\"history\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.ID))
+		easyjson2a877177Encode35(out, in.History)
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v mutationResponse) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal47(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v mutationResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal47(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *mutationResponse) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal47(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *mutationResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal47(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal48(in *jlexer.Lexer, out *milestoneNodes) {
+func easyjson2a877177Decode35(in *jlexer.Lexer, out *struct {
+	TotalCount int      `json:"totalCount"`
+	PageInfo   pageInfo `json:"pageInfo"`
+	Nodes      []commit `json:"nodes"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4987,6 +11297,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal48(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
+		case "totalCount":
+			out.TotalCount = int(in.Int())
 		case "pageInfo":
 			(out.PageInfo).UnmarshalEasyJSON(in)
 		case "nodes":
@@ -4997,17 +11309,17 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal48(in *jlexer.Lexer, out
 				in.Delim('[')
 				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.Nodes = make([]milestone, 0, 1)
+						out.Nodes = make([]commit, 0, 1)
 					} else {
-						out.Nodes = []milestone{}
+						out.Nodes = []commit{}
 					}
 				} else {
 					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v43 milestone
-					(v43).UnmarshalEasyJSON(in)
-					out.Nodes = append(out.Nodes, v43)
+					var v97 commit
+					(v97).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v97)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5022,13 +11334,22 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal48(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal48(out *jwriter.Writer, in milestoneNodes) {
+func easyjson2a877177Encode35(out *jwriter.Writer, in struct {
+	TotalCount int      `json:"totalCount"`
+	PageInfo   pageInfo `json:"pageInfo"`
+	Nodes      []commit `json:"nodes"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"pageInfo\":"
+		const prefix string = ",\"totalCount\":"
 		out.RawString(prefix[1:])
+		out.Int(int(in.TotalCount))
+	}
+	{
+		const prefix string = ",\"pageInfo\":"
+		out.RawString(prefix)
 		(in.PageInfo).MarshalEasyJSON(out)
 	}
 	{
@@ -5038,42 +11359,18 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal48(out *jwriter.Writer, i
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v44, v45 := range in.Nodes {
-				if v44 > 0 {
+			for v98, v99 := range in.Nodes {
+				if v98 > 0 {
 					out.RawByte(',')
 				}
-				(v45).MarshalEasyJSON(out)
+				(v99).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v milestoneNodes) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal48(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v milestoneNodes) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal48(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *milestoneNodes) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal48(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *milestoneNodes) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal48(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal49(in *jlexer.Lexer, out *milestone) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal89(in *jlexer.Lexer, out *compareResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5092,163 +11389,101 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal49(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "title":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		case "number":
-			out.Number = int(in.Int())
-		case "url":
-			out.URL = string(in.String())
-		case "closed":
-			out.Closed = bool(in.Bool())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "closedAt":
-			if in.IsNull() {
-				in.Skip()
-				out.ClosedAt = nil
-			} else {
-				if out.ClosedAt == nil {
-					out.ClosedAt = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
-				}
-			}
-		case "dueOn":
+		case "total_commits":
+			out.TotalCommits = int(in.Int())
+		case "commits":
 			if in.IsNull() {
 				in.Skip()
-				out.DueAt = nil
+				out.Commits = nil
 			} else {
-				if out.DueAt == nil {
-					out.DueAt = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.DueAt).UnmarshalJSON(data))
+				in.Delim('[')
+				if out.Commits == nil {
+					if !in.IsDelim(']') {
+						out.Commits = make([]struct {
+							Sha string `json:"sha"`
+						}, 0, 4)
+					} else {
+						out.Commits = []struct {
+							Sha string `json:"sha"`
+						}{}
+					}
+				} else {
+					out.Commits = (out.Commits)[:0]
 				}
-			}
-		case "state":
-			out.State = string(in.String())
-		case "creator":
-			(out.Creator).UnmarshalEasyJSON(in)
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson2a877177EncodeGithubComPinptGithubInternal49(out *jwriter.Writer, in milestone) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"description\":"
-		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	{
-		const prefix string = ",\"number\":"
-		out.RawString(prefix)
-		out.Int(int(in.Number))
-	}
-	{
-		const prefix string = ",\"url\":"
-		out.RawString(prefix)
-		out.String(string(in.URL))
-	}
-	{
-		const prefix string = ",\"closed\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Closed))
-	}
-	{
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+				for !in.IsDelim(']') {
+					var v100 struct {
+						Sha string `json:"sha"`
+					}
+					easyjson2a877177Decode36(in, &v100)
+					out.Commits = append(out.Commits, v100)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
 	}
-	{
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal89(out *jwriter.Writer, in compareResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
 	{
-		const prefix string = ",\"closedAt\":"
-		out.RawString(prefix)
-		if in.ClosedAt == nil {
-			out.RawString("null")
-		} else {
-			out.Raw((*in.ClosedAt).MarshalJSON())
-		}
+		const prefix string = ",\"total_commits\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.TotalCommits))
 	}
 	{
-		const prefix string = ",\"dueOn\":"
+		const prefix string = ",\"commits\":"
 		out.RawString(prefix)
-		if in.DueAt == nil {
+		if in.Commits == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.Raw((*in.DueAt).MarshalJSON())
+			out.RawByte('[')
+			for v101, v102 := range in.Commits {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				easyjson2a877177Encode36(out, v102)
+			}
+			out.RawByte(']')
 		}
 	}
-	{
-		const prefix string = ",\"state\":"
-		out.RawString(prefix)
-		out.String(string(in.State))
-	}
-	{
-		const prefix string = ",\"creator\":"
-		out.RawString(prefix)
-		(in.Creator).MarshalEasyJSON(out)
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v milestone) MarshalJSON() ([]byte, error) {
+func (v compareResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal49(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal89(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v milestone) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal49(w, v)
+func (v compareResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal89(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *milestone) UnmarshalJSON(data []byte) error {
+func (v *compareResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal49(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal89(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *milestone) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal49(l, v)
+func (v *compareResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal89(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal50(in *jlexer.Lexer, out *labelNode) {
+func easyjson2a877177Decode36(in *jlexer.Lexer, out *struct {
+	Sha string `json:"sha"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5267,29 +11502,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal50(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "nodes":
-			if in.IsNull() {
-				in.Skip()
-				out.Nodes = nil
-			} else {
-				in.Delim('[')
-				if out.Nodes == nil {
-					if !in.IsDelim(']') {
-						out.Nodes = make([]label, 0, 1)
-					} else {
-						out.Nodes = []label{}
-					}
-				} else {
-					out.Nodes = (out.Nodes)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v46 label
-					(v46).UnmarshalEasyJSON(in)
-					out.Nodes = append(out.Nodes, v46)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+		case "sha":
+			out.Sha = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5300,53 +11514,116 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal50(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal50(out *jwriter.Writer, in labelNode) {
+func easyjson2a877177Encode36(out *jwriter.Writer, in struct {
+	Sha string `json:"sha"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"nodes\":"
+		const prefix string = ",\"sha\":"
 		out.RawString(prefix[1:])
-		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v47, v48 := range in.Nodes {
-				if v47 > 0 {
-					out.RawByte(',')
-				}
-				(v48).MarshalEasyJSON(out)
+		out.String(string(in.Sha))
+	}
+	out.RawByte('}')
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal90(in *jlexer.Lexer, out *commitStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "context":
+			out.Context = string(in.String())
+		case "state":
+			out.State = string(in.String())
+		case "targetUrl":
+			out.TargetURL = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
-			out.RawByte(']')
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal90(out *jwriter.Writer, in commitStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"context\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Context))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"targetUrl\":"
+		out.RawString(prefix)
+		out.String(string(in.TargetURL))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v labelNode) MarshalJSON() ([]byte, error) {
+func (v commitStatus) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal50(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal90(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v labelNode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal50(w, v)
+func (v commitStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal90(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *labelNode) UnmarshalJSON(data []byte) error {
+func (v *commitStatus) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal50(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal90(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *labelNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal50(l, v)
+func (v *commitStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal90(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal51(in *jlexer.Lexer, out *label) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal91(in *jlexer.Lexer, out *commit) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5365,12 +11642,26 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal51(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "name":
-			out.Name = string(in.String())
-		case "description":
-			out.Description = string(in.String())
+		case "sha":
+			out.Sha = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		case "authoredDate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Date).UnmarshalJSON(data))
+			}
+		case "additions":
+			out.Additions = int64(in.Int64())
+		case "deletions":
+			out.Deletions = int64(in.Int64())
+		case "changedFiles":
+			out.ChangedFiles = int64(in.Int64())
+		case "url":
+			out.URL = string(in.String())
+		case "author":
+			(out.Author).UnmarshalEasyJSON(in)
+		case "committer":
+			(out.Committer).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -5381,52 +11672,82 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal51(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal51(out *jwriter.Writer, in label) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal91(out *jwriter.Writer, in commit) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"sha\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
+		out.String(string(in.Sha))
 	}
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"message\":"
 		out.RawString(prefix)
-		out.String(string(in.Name))
+		out.String(string(in.Message))
 	}
 	{
-		const prefix string = ",\"description\":"
+		const prefix string = ",\"authoredDate\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
+		out.Raw((in.Date).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"additions\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Additions))
+	}
+	{
+		const prefix string = ",\"deletions\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Deletions))
+	}
+	{
+		const prefix string = ",\"changedFiles\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ChangedFiles))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		(in.Author).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"committer\":"
+		out.RawString(prefix)
+		(in.Committer).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v label) MarshalJSON() ([]byte, error) {
+func (v commit) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal51(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal91(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v label) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal51(w, v)
+func (v commit) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal91(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *label) UnmarshalJSON(data []byte) error {
+func (v *commit) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal51(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal91(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *label) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal51(l, v)
+func (v *commit) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal91(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal52(in *jlexer.Lexer, out *issueUpdateResponse) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal92(in *jlexer.Lexer, out *commentsNode) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5445,33 +11766,29 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal52(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "data":
-			easyjson2a877177Decode10(in, &out.Data)
-		case "errors":
+		case "totalCount":
+			out.TotalCount = int(in.Int())
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
 			if in.IsNull() {
 				in.Skip()
-				out.Errors = nil
+				out.Nodes = nil
 			} else {
 				in.Delim('[')
-				if out.Errors == nil {
+				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.Errors = make([]struct {
-							Message string `json:"message"`
-						}, 0, 4)
+						out.Nodes = make([]comment, 0, 1)
 					} else {
-						out.Errors = []struct {
-							Message string `json:"message"`
-						}{}
+						out.Nodes = []comment{}
 					}
 				} else {
-					out.Errors = (out.Errors)[:0]
+					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v49 struct {
-						Message string `json:"message"`
-					}
-					easyjson2a877177Decode11(in, &v49)
-					out.Errors = append(out.Errors, v49)
+					var v103 comment
+					(v103).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v103)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -5486,27 +11803,32 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal52(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal52(out *jwriter.Writer, in issueUpdateResponse) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal92(out *jwriter.Writer, in commentsNode) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"data\":"
+		const prefix string = ",\"totalCount\":"
 		out.RawString(prefix[1:])
-		easyjson2a877177Encode10(out, in.Data)
+		out.Int(int(in.TotalCount))
 	}
 	{
-		const prefix string = ",\"errors\":"
+		const prefix string = ",\"pageInfo\":"
 		out.RawString(prefix)
-		if in.Errors == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		(in.PageInfo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"nodes\":"
+		out.RawString(prefix)
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v50, v51 := range in.Errors {
-				if v50 > 0 {
+			for v104, v105 := range in.Nodes {
+				if v104 > 0 {
 					out.RawByte(',')
 				}
-				easyjson2a877177Encode11(out, v51)
+				(v105).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -5515,31 +11837,29 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal52(out *jwriter.Writer, i
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v issueUpdateResponse) MarshalJSON() ([]byte, error) {
+func (v commentsNode) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal52(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal92(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v issueUpdateResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal52(w, v)
+func (v commentsNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal92(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *issueUpdateResponse) UnmarshalJSON(data []byte) error {
+func (v *commentsNode) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal52(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal92(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *issueUpdateResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal52(l, v)
+func (v *commentsNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal92(l, v)
 }
-func easyjson2a877177Decode11(in *jlexer.Lexer, out *struct {
-	Message string `json:"message"`
-}) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal93(in *jlexer.Lexer, out *comment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5558,8 +11878,22 @@ func easyjson2a877177Decode11(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "message":
-			out.Message = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "body":
+			out.Body = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "updatedAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "author":
+			(out.Author).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -5570,24 +11904,67 @@ func easyjson2a877177Decode11(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjson2a877177Encode11(out *jwriter.Writer, in struct {
-	Message string `json:"message"`
-}) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal93(out *jwriter.Writer, in comment) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"message\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"body\":"
+		out.RawString(prefix)
+		out.String(string(in.Body))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"author\":"
+		out.RawString(prefix)
+		(in.Author).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
-func easyjson2a877177Decode10(in *jlexer.Lexer, out *struct {
-	CreateIssue struct {
-		Issue CreateIssue `json:"issue"`
-	} `json:"updateIssue"`
-}) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v comment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal93(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v comment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal93(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *comment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal93(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *comment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal93(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal94(in *jlexer.Lexer, out *childTeam) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5606,8 +11983,8 @@ func easyjson2a877177Decode10(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "updateIssue":
-			easyjson2a877177Decode12(in, &out.CreateIssue)
+		case "id":
+			out.ID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5618,24 +11995,42 @@ func easyjson2a877177Decode10(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjson2a877177Encode10(out *jwriter.Writer, in struct {
-	CreateIssue struct {
-		Issue CreateIssue `json:"issue"`
-	} `json:"updateIssue"`
-}) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal94(out *jwriter.Writer, in childTeam) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"updateIssue\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		easyjson2a877177Encode12(out, in.CreateIssue)
+		out.String(string(in.ID))
 	}
 	out.RawByte('}')
 }
-func easyjson2a877177Decode12(in *jlexer.Lexer, out *struct {
-	Issue CreateIssue `json:"issue"`
-}) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v childTeam) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal94(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v childTeam) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal94(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *childTeam) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal94(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *childTeam) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal94(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal95(in *jlexer.Lexer, out *checkpointStage) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5654,8 +12049,12 @@ func easyjson2a877177Decode12(in *jlexer.Lexer, out *struct {
 			continue
 		}
 		switch key {
-		case "issue":
-			(out.Issue).UnmarshalEasyJSON(in)
+		case "completed":
+			out.Completed = bool(in.Bool())
+		case "cursor":
+			out.Cursor = string(in.String())
+		case "first":
+			out.First = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -5666,20 +12065,52 @@ func easyjson2a877177Decode12(in *jlexer.Lexer, out *struct {
 		in.Consumed()
 	}
 }
-func easyjson2a877177Encode12(out *jwriter.Writer, in struct {
-	Issue CreateIssue `json:"issue"`
-}) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal95(out *jwriter.Writer, in checkpointStage) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"issue\":"
+		const prefix string = ",\"completed\":"
 		out.RawString(prefix[1:])
-		(in.Issue).MarshalEasyJSON(out)
+		out.Bool(bool(in.Completed))
+	}
+	if in.Cursor != "" {
+		const prefix string = ",\"cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.Cursor))
+	}
+	if in.First != "" {
+		const prefix string = ",\"first\":"
+		out.RawString(prefix)
+		out.String(string(in.First))
 	}
 	out.RawByte('}')
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal53(in *jlexer.Lexer, out *issueResult) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v checkpointStage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2a877177EncodeGithubComPinptGithubInternal95(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v checkpointStage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal95(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *checkpointStage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2a877177DecodeGithubComPinptGithubInternal95(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *checkpointStage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal95(l, v)
+}
+func easyjson2a877177DecodeGithubComPinptGithubInternal96(in *jlexer.Lexer, out *checkpointJob) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5698,10 +12129,18 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal53(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "rateLimit":
-			(out.RateLimit).UnmarshalEasyJSON(in)
-		case "repository":
-			(out.Repository).UnmarshalEasyJSON(in)
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = string(in.String())
+		case "number":
+			out.Number = int(in.Int())
+		case "cursor":
+			out.Cursor = string(in.String())
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -5712,47 +12151,62 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal53(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal53(out *jwriter.Writer, in issueResult) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal96(out *jwriter.Writer, in checkpointJob) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"rateLimit\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
-		(in.RateLimit).MarshalEasyJSON(out)
+		out.String(string(in.Type))
 	}
 	{
-		const prefix string = ",\"repository\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix)
-		(in.Repository).MarshalEasyJSON(out)
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"number\":"
+		out.RawString(prefix)
+		out.Int(int(in.Number))
+	}
+	{
+		const prefix string = ",\"cursor\":"
+		out.RawString(prefix)
+		out.String(string(in.Cursor))
+	}
+	if true {
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v issueResult) MarshalJSON() ([]byte, error) {
+func (v checkpointJob) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal53(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal96(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v issueResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal53(w, v)
+func (v checkpointJob) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal96(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *issueResult) UnmarshalJSON(data []byte) error {
+func (v *checkpointJob) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal53(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal96(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *issueResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal53(l, v)
+func (v *checkpointJob) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal96(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal54(in *jlexer.Lexer, out *issueRepository) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal97(in *jlexer.Lexer, out *branchRef) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5771,8 +12225,10 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal54(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "issues":
-			(out.Issues).UnmarshalEasyJSON(in)
+		case "name":
+			out.Name = string(in.String())
+		case "target":
+			easyjson2a877177Decode9(in, &out.Target)
 		default:
 			in.SkipRecursive()
 		}
@@ -5783,42 +12239,47 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal54(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal54(out *jwriter.Writer, in issueRepository) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal97(out *jwriter.Writer, in branchRef) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"issues\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix[1:])
-		(in.Issues).MarshalEasyJSON(out)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"target\":"
+		out.RawString(prefix)
+		easyjson2a877177Encode9(out, in.Target)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v issueRepository) MarshalJSON() ([]byte, error) {
+func (v branchRef) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal54(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal97(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v issueRepository) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal54(w, v)
+func (v branchRef) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal97(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *issueRepository) UnmarshalJSON(data []byte) error {
+func (v *branchRef) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal54(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal97(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *issueRepository) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal54(l, v)
+func (v *branchRef) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal97(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal55(in *jlexer.Lexer, out *issueNode) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal98(in *jlexer.Lexer, out *branchNamesResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5834,36 +12295,13 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal55(in *jlexer.Lexer, out
 		if in.IsNull() {
 			in.Skip()
 			in.WantComma()
-			continue
-		}
-		switch key {
-		case "totalCount":
-			out.TotalCount = int(in.Int())
-		case "pageInfo":
-			(out.PageInfo).UnmarshalEasyJSON(in)
-		case "nodes":
-			if in.IsNull() {
-				in.Skip()
-				out.Nodes = nil
-			} else {
-				in.Delim('[')
-				if out.Nodes == nil {
-					if !in.IsDelim(']') {
-						out.Nodes = make([]issue, 0, 1)
-					} else {
-						out.Nodes = []issue{}
-					}
-				} else {
-					out.Nodes = (out.Nodes)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v52 issue
-					(v52).UnmarshalEasyJSON(in)
-					out.Nodes = append(out.Nodes, v52)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
+			continue
+		}
+		switch key {
+		case "rateLimit":
+			(out.RateLimit).UnmarshalEasyJSON(in)
+		case "repository":
+			easyjson2a877177Decode37(in, &out.Repository)
 		default:
 			in.SkipRecursive()
 		}
@@ -5874,63 +12312,54 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal55(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal55(out *jwriter.Writer, in issueNode) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal98(out *jwriter.Writer, in branchNamesResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"totalCount\":"
+		const prefix string = ",\"rateLimit\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.TotalCount))
-	}
-	{
-		const prefix string = ",\"pageInfo\":"
-		out.RawString(prefix)
-		(in.PageInfo).MarshalEasyJSON(out)
+		(in.RateLimit).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"nodes\":"
+		const prefix string = ",\"repository\":"
 		out.RawString(prefix)
-		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v53, v54 := range in.Nodes {
-				if v53 > 0 {
-					out.RawByte(',')
-				}
-				(v54).MarshalEasyJSON(out)
-			}
-			out.RawByte(']')
-		}
+		easyjson2a877177Encode37(out, in.Repository)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v issueNode) MarshalJSON() ([]byte, error) {
+func (v branchNamesResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal55(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal98(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v issueNode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal55(w, v)
+func (v branchNamesResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal98(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *issueNode) UnmarshalJSON(data []byte) error {
+func (v *branchNamesResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal55(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal98(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *issueNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal55(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal56(in *jlexer.Lexer, out *issueMilestone) {
+func (v *branchNamesResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal98(l, v)
+}
+func easyjson2a877177Decode37(in *jlexer.Lexer, out *struct {
+	Refs struct {
+		PageInfo pageInfo `json:"pageInfo"`
+		Nodes    []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"refs"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -5949,8 +12378,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal56(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
+		case "refs":
+			easyjson2a877177Decode38(in, &out.Refs)
 		default:
 			in.SkipRecursive()
 		}
@@ -5961,42 +12390,30 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal56(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal56(out *jwriter.Writer, in issueMilestone) {
+func easyjson2a877177Encode37(out *jwriter.Writer, in struct {
+	Refs struct {
+		PageInfo pageInfo `json:"pageInfo"`
+		Nodes    []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"refs"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"refs\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
+		easyjson2a877177Encode38(out, in.Refs)
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v issueMilestone) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal56(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v issueMilestone) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal56(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *issueMilestone) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal56(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *issueMilestone) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal56(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal57(in *jlexer.Lexer, out *issue) {
+func easyjson2a877177Decode38(in *jlexer.Lexer, out *struct {
+	PageInfo pageInfo `json:"pageInfo"`
+	Nodes    []struct {
+		Name string `json:"name"`
+	} `json:"nodes"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6015,57 +12432,36 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal57(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "closedAt":
+		case "pageInfo":
+			(out.PageInfo).UnmarshalEasyJSON(in)
+		case "nodes":
 			if in.IsNull() {
 				in.Skip()
-				out.ClosedAt = nil
+				out.Nodes = nil
 			} else {
-				if out.ClosedAt == nil {
-					out.ClosedAt = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.ClosedAt).UnmarshalJSON(data))
+				in.Delim('[')
+				if out.Nodes == nil {
+					if !in.IsDelim(']') {
+						out.Nodes = make([]struct {
+							Name string `json:"name"`
+						}, 0, 4)
+					} else {
+						out.Nodes = []struct {
+							Name string `json:"name"`
+						}{}
+					}
+				} else {
+					out.Nodes = (out.Nodes)[:0]
 				}
-			}
-		case "state":
-			out.State = string(in.String())
-		case "url":
-			out.URL = string(in.String())
-		case "title":
-			out.Title = string(in.String())
-		case "body":
-			out.Body = string(in.String())
-		case "closed":
-			out.Closed = bool(in.Bool())
-		case "labels":
-			(out.Labels).UnmarshalEasyJSON(in)
-		case "comments":
-			(out.Comments).UnmarshalEasyJSON(in)
-		case "assignees":
-			(out.Assignees).UnmarshalEasyJSON(in)
-		case "author":
-			(out.Author).UnmarshalEasyJSON(in)
-		case "number":
-			out.Number = int(in.Int())
-		case "milestone":
-			if in.IsNull() {
-				in.Skip()
-				out.Milestone = nil
-			} else {
-				if out.Milestone == nil {
-					out.Milestone = new(issueMilestone)
+				for !in.IsDelim(']') {
+					var v106 struct {
+						Name string `json:"name"`
+					}
+					easyjson2a877177Decode24(in, &v106)
+					out.Nodes = append(out.Nodes, v106)
+					in.WantComma()
 				}
-				(*out.Milestone).UnmarshalEasyJSON(in)
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -6077,120 +12473,39 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal57(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal57(out *jwriter.Writer, in issue) {
+func easyjson2a877177Encode38(out *jwriter.Writer, in struct {
+	PageInfo pageInfo `json:"pageInfo"`
+	Nodes    []struct {
+		Name string `json:"name"`
+	} `json:"nodes"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"pageInfo\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"updatedAt\":"
-		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
-	}
-	{
-		const prefix string = ",\"closedAt\":"
-		out.RawString(prefix)
-		if in.ClosedAt == nil {
-			out.RawString("null")
-		} else {
-			out.Raw((*in.ClosedAt).MarshalJSON())
-		}
-	}
-	{
-		const prefix string = ",\"state\":"
-		out.RawString(prefix)
-		out.String(string(in.State))
-	}
-	{
-		const prefix string = ",\"url\":"
-		out.RawString(prefix)
-		out.String(string(in.URL))
-	}
-	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"body\":"
-		out.RawString(prefix)
-		out.String(string(in.Body))
-	}
-	{
-		const prefix string = ",\"closed\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Closed))
-	}
-	{
-		const prefix string = ",\"labels\":"
-		out.RawString(prefix)
-		(in.Labels).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"comments\":"
-		out.RawString(prefix)
-		(in.Comments).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"assignees\":"
-		out.RawString(prefix)
-		(in.Assignees).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"author\":"
-		out.RawString(prefix)
-		(in.Author).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"number\":"
-		out.RawString(prefix)
-		out.Int(int(in.Number))
+		(in.PageInfo).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"milestone\":"
+		const prefix string = ",\"nodes\":"
 		out.RawString(prefix)
-		if in.Milestone == nil {
+		if in.Nodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			(*in.Milestone).MarshalEasyJSON(out)
+			out.RawByte('[')
+			for v107, v108 := range in.Nodes {
+				if v107 > 0 {
+					out.RawByte(',')
+				}
+				easyjson2a877177Encode24(out, v108)
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v issue) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal57(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v issue) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal57(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *issue) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal57(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *issue) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal57(l, v)
-}
-func easyjson2a877177DecodeGithubComPinptGithubInternal58(in *jlexer.Lexer, out *gitUser) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal99(in *jlexer.Lexer, out *branchComparison) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6209,14 +12524,14 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal58(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "name":
-			out.Name = string(in.String())
-		case "email":
-			out.Email = string(in.String())
-		case "avatarUrl":
-			out.Avatar = string(in.String())
-		case "user":
-			(out.User).UnmarshalEasyJSON(in)
+		case "aheadBy":
+			out.AheadBy = int64(in.Int64())
+		case "behindBy":
+			out.BehindBy = int64(in.Int64())
+		case "status":
+			out.Status = string(in.String())
+		case "commits":
+			easyjson2a877177Decode39(in, &out.Commits)
 		default:
 			in.SkipRecursive()
 		}
@@ -6227,57 +12542,62 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal58(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal58(out *jwriter.Writer, in gitUser) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal99(out *jwriter.Writer, in branchComparison) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"aheadBy\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Name))
+		out.Int64(int64(in.AheadBy))
 	}
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"behindBy\":"
 		out.RawString(prefix)
-		out.String(string(in.Email))
+		out.Int64(int64(in.BehindBy))
 	}
 	{
-		const prefix string = ",\"avatarUrl\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
-		out.String(string(in.Avatar))
+		out.String(string(in.Status))
 	}
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"commits\":"
 		out.RawString(prefix)
-		(in.User).MarshalEasyJSON(out)
+		easyjson2a877177Encode39(out, in.Commits)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v gitUser) MarshalJSON() ([]byte, error) {
+func (v branchComparison) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal58(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal99(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v gitUser) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal58(w, v)
+func (v branchComparison) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal99(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *gitUser) UnmarshalJSON(data []byte) error {
+func (v *branchComparison) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal58(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal99(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *gitUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal58(l, v)
+func (v *branchComparison) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal99(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal59(in *jlexer.Lexer, out *commentsNode) {
+func easyjson2a877177Decode39(in *jlexer.Lexer, out *struct {
+	Nodes []struct {
+		Oid           string    `json:"oid"`
+		CommittedDate time.Time `json:"committedDate"`
+	} `json:"nodes"`
+}) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6304,17 +12624,26 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal59(in *jlexer.Lexer, out
 				in.Delim('[')
 				if out.Nodes == nil {
 					if !in.IsDelim(']') {
-						out.Nodes = make([]comment, 0, 1)
+						out.Nodes = make([]struct {
+							Oid           string    `json:"oid"`
+							CommittedDate time.Time `json:"committedDate"`
+						}, 0, 1)
 					} else {
-						out.Nodes = []comment{}
+						out.Nodes = []struct {
+							Oid           string    `json:"oid"`
+							CommittedDate time.Time `json:"committedDate"`
+						}{}
 					}
 				} else {
 					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v55 comment
-					(v55).UnmarshalEasyJSON(in)
-					out.Nodes = append(out.Nodes, v55)
+					var v109 struct {
+						Oid           string    `json:"oid"`
+						CommittedDate time.Time `json:"committedDate"`
+					}
+					easyjson2a877177Decode9(in, &v109)
+					out.Nodes = append(out.Nodes, v109)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -6329,7 +12658,12 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal59(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal59(out *jwriter.Writer, in commentsNode) {
+func easyjson2a877177Encode39(out *jwriter.Writer, in struct {
+	Nodes []struct {
+		Oid           string    `json:"oid"`
+		CommittedDate time.Time `json:"committedDate"`
+	} `json:"nodes"`
+}) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6340,42 +12674,119 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal59(out *jwriter.Writer, i
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v56, v57 := range in.Nodes {
-				if v56 > 0 {
+			for v110, v111 := range in.Nodes {
+				if v110 > 0 {
 					out.RawByte(',')
 				}
-				(v57).MarshalEasyJSON(out)
+				easyjson2a877177Encode9(out, v111)
 			}
 			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
+func easyjson2a877177DecodeGithubComPinptGithubInternal100(in *jlexer.Lexer, out *authorCommon) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeString()
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "email":
+			out.Email = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "avatarUrl":
+			out.Avatar = string(in.String())
+		case "login":
+			out.Login = string(in.String())
+		case "url":
+			out.URL = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2a877177EncodeGithubComPinptGithubInternal100(out *jwriter.Writer, in authorCommon) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Email))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"avatarUrl\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"login\":"
+		out.RawString(prefix)
+		out.String(string(in.Login))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	out.RawByte('}')
+}
 
 // MarshalJSON supports json.Marshaler interface
-func (v commentsNode) MarshalJSON() ([]byte, error) {
+func (v authorCommon) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal59(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal100(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v commentsNode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal59(w, v)
+func (v authorCommon) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal100(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *commentsNode) UnmarshalJSON(data []byte) error {
+func (v *authorCommon) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal59(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal100(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *commentsNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal59(l, v)
+func (v *authorCommon) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal100(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal60(in *jlexer.Lexer, out *comment) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal101(in *jlexer.Lexer, out *author2) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6395,21 +12806,19 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal60(in *jlexer.Lexer, out
 		}
 		switch key {
 		case "id":
-			out.ID = string(in.String())
+			out.ID = int64(in.Int64())
+		case "email":
+			out.Email = string(in.String())
+		case "name":
+			out.Name = string(in.String())
+		case "avatarUrl":
+			out.Avatar = string(in.String())
+		case "login":
+			out.Login = string(in.String())
 		case "url":
 			out.URL = string(in.String())
-		case "body":
-			out.Body = string(in.String())
-		case "createdAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.CreatedAt).UnmarshalJSON(data))
-			}
-		case "updatedAt":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
-			}
-		case "author":
-			(out.Author).UnmarshalEasyJSON(in)
+		case "type":
+			out.Type = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -6420,67 +12829,72 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal60(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal60(out *jwriter.Writer, in comment) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal101(out *jwriter.Writer, in author2) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
 		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ID))
+		out.Int64(int64(in.ID))
 	}
 	{
-		const prefix string = ",\"url\":"
+		const prefix string = ",\"email\":"
 		out.RawString(prefix)
-		out.String(string(in.URL))
+		out.String(string(in.Email))
 	}
 	{
-		const prefix string = ",\"body\":"
+		const prefix string = ",\"name\":"
 		out.RawString(prefix)
-		out.String(string(in.Body))
+		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"createdAt\":"
+		const prefix string = ",\"avatarUrl\":"
 		out.RawString(prefix)
-		out.Raw((in.CreatedAt).MarshalJSON())
+		out.String(string(in.Avatar))
 	}
 	{
-		const prefix string = ",\"updatedAt\":"
+		const prefix string = ",\"login\":"
 		out.RawString(prefix)
-		out.Raw((in.UpdatedAt).MarshalJSON())
+		out.String(string(in.Login))
 	}
 	{
-		const prefix string = ",\"author\":"
+		const prefix string = ",\"url\":"
 		out.RawString(prefix)
-		(in.Author).MarshalEasyJSON(out)
+		out.String(string(in.URL))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v comment) MarshalJSON() ([]byte, error) {
+func (v author2) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal60(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal101(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v comment) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal60(w, v)
+func (v author2) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2a877177EncodeGithubComPinptGithubInternal101(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *comment) UnmarshalJSON(data []byte) error {
+func (v *author2) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal60(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal101(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *comment) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal60(l, v)
+func (v *author2) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2a877177DecodeGithubComPinptGithubInternal101(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal61(in *jlexer.Lexer, out *author) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal102(in *jlexer.Lexer, out *author) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6523,7 +12937,7 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal61(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal61(out *jwriter.Writer, in author) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal102(out *jwriter.Writer, in author) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6568,27 +12982,27 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal61(out *jwriter.Writer, i
 // MarshalJSON supports json.Marshaler interface
 func (v author) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal61(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal102(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v author) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal61(w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal102(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *author) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal61(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal102(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *author) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal61(l, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal102(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal62(in *jlexer.Lexer, out *assigneesNode) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal103(in *jlexer.Lexer, out *assigneesNode) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6623,9 +13037,9 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal62(in *jlexer.Lexer, out
 					out.Nodes = (out.Nodes)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v58 author
-					(v58).UnmarshalEasyJSON(in)
-					out.Nodes = append(out.Nodes, v58)
+					var v112 author
+					(v112).UnmarshalEasyJSON(in)
+					out.Nodes = append(out.Nodes, v112)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -6640,7 +13054,7 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal62(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal62(out *jwriter.Writer, in assigneesNode) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal103(out *jwriter.Writer, in assigneesNode) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6651,11 +13065,11 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal62(out *jwriter.Writer, i
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v59, v60 := range in.Nodes {
-				if v59 > 0 {
+			for v113, v114 := range in.Nodes {
+				if v113 > 0 {
 					out.RawByte(',')
 				}
-				(v60).MarshalEasyJSON(out)
+				(v114).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -6666,27 +13080,27 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal62(out *jwriter.Writer, i
 // MarshalJSON supports json.Marshaler interface
 func (v assigneesNode) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal62(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal103(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v assigneesNode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal62(w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal103(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *assigneesNode) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal62(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal103(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *assigneesNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal62(l, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal103(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal63(in *jlexer.Lexer, out *allOrgsResult) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal104(in *jlexer.Lexer, out *allOrgsResult) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6719,7 +13133,7 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal63(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal63(out *jwriter.Writer, in allOrgsResult) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal104(out *jwriter.Writer, in allOrgsResult) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6739,27 +13153,27 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal63(out *jwriter.Writer, i
 // MarshalJSON supports json.Marshaler interface
 func (v allOrgsResult) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal63(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal104(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v allOrgsResult) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal63(w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal104(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *allOrgsResult) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal63(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal104(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *allOrgsResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal63(l, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal104(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal64(in *jlexer.Lexer, out *allOrgViewOrg) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal105(in *jlexer.Lexer, out *allOrgViewOrg) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6790,7 +13204,7 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal64(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal64(out *jwriter.Writer, in allOrgViewOrg) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal105(out *jwriter.Writer, in allOrgViewOrg) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6805,27 +13219,27 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal64(out *jwriter.Writer, i
 // MarshalJSON supports json.Marshaler interface
 func (v allOrgViewOrg) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal64(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal105(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v allOrgViewOrg) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal64(w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal105(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *allOrgViewOrg) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal64(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal105(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *allOrgViewOrg) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal64(l, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal105(l, v)
 }
-func easyjson2a877177DecodeGithubComPinptGithubInternal65(in *jlexer.Lexer, out *CreateIssue) {
+func easyjson2a877177DecodeGithubComPinptGithubInternal106(in *jlexer.Lexer, out *CreateIssue) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -6857,7 +13271,7 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal65(in *jlexer.Lexer, out
 		case "state":
 			out.State = string(in.String())
 		case "repository":
-			easyjson2a877177Decode13(in, &out.Repository)
+			easyjson2a877177Decode28(in, &out.Repository)
 		case "createdAt":
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.CreatedAt).UnmarshalJSON(data))
@@ -6872,6 +13286,8 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal65(in *jlexer.Lexer, out
 				}
 				easyjson2a877177DecodeGithubComGoogleGoGithubV32Github(in, out.Author)
 			}
+		case "labels":
+			(out.Labels).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -6882,7 +13298,7 @@ func easyjson2a877177DecodeGithubComPinptGithubInternal65(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjson2a877177EncodeGithubComPinptGithubInternal65(out *jwriter.Writer, in CreateIssue) {
+func easyjson2a877177EncodeGithubComPinptGithubInternal106(out *jwriter.Writer, in CreateIssue) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -6919,7 +13335,7 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal65(out *jwriter.Writer, i
 	{
 		const prefix string = ",\"repository\":"
 		out.RawString(prefix)
-		easyjson2a877177Encode13(out, in.Repository)
+		easyjson2a877177Encode28(out, in.Repository)
 	}
 	{
 		const prefix string = ",\"createdAt\":"
@@ -6935,31 +13351,36 @@ func easyjson2a877177EncodeGithubComPinptGithubInternal65(out *jwriter.Writer, i
 			easyjson2a877177EncodeGithubComGoogleGoGithubV32Github(out, *in.Author)
 		}
 	}
+	{
+		const prefix string = ",\"labels\":"
+		out.RawString(prefix)
+		(in.Labels).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CreateIssue) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2a877177EncodeGithubComPinptGithubInternal65(&w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal106(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateIssue) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2a877177EncodeGithubComPinptGithubInternal65(w, v)
+	easyjson2a877177EncodeGithubComPinptGithubInternal106(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateIssue) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2a877177DecodeGithubComPinptGithubInternal65(&r, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal106(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateIssue) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2a877177DecodeGithubComPinptGithubInternal65(l, v)
+	easyjson2a877177DecodeGithubComPinptGithubInternal106(l, v)
 }
 func easyjson2a877177DecodeGithubComGoogleGoGithubV32Github(in *jlexer.Lexer, out *github.User) {
 	isTopLevel := in.IsStart()
@@ -7402,17 +13823,17 @@ func easyjson2a877177DecodeGithubComGoogleGoGithubV32Github(in *jlexer.Lexer, ou
 					out.TextMatches = (out.TextMatches)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v61 *github.TextMatch
+					var v115 *github.TextMatch
 					if in.IsNull() {
 						in.Skip()
-						v61 = nil
+						v115 = nil
 					} else {
-						if v61 == nil {
-							v61 = new(github.TextMatch)
+						if v115 == nil {
+							v115 = new(github.TextMatch)
 						}
-						easyjson2a877177DecodeGithubComGoogleGoGithubV32Github2(in, v61)
+						easyjson2a877177DecodeGithubComGoogleGoGithubV32Github2(in, v115)
 					}
-					out.TextMatches = append(out.TextMatches, v61)
+					out.TextMatches = append(out.TextMatches, v115)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -7437,9 +13858,9 @@ func easyjson2a877177DecodeGithubComGoogleGoGithubV32Github(in *jlexer.Lexer, ou
 					for !in.IsDelim('}') {
 						key := string(in.String())
 						in.WantColon()
-						var v62 bool
-						v62 = bool(in.Bool())
-						(*out.Permissions)[key] = v62
+						var v116 bool
+						v116 = bool(in.Bool())
+						(*out.Permissions)[key] = v116
 						in.WantComma()
 					}
 					in.Delim('}')
@@ -7865,14 +14286,14 @@ func easyjson2a877177EncodeGithubComGoogleGoGithubV32Github(out *jwriter.Writer,
 		}
 		{
 			out.RawByte('[')
-			for v63, v64 := range in.TextMatches {
-				if v63 > 0 {
+			for v117, v118 := range in.TextMatches {
+				if v117 > 0 {
 					out.RawByte(',')
 				}
-				if v64 == nil {
+				if v118 == nil {
 					out.RawString("null")
 				} else {
-					easyjson2a877177EncodeGithubComGoogleGoGithubV32Github2(out, *v64)
+					easyjson2a877177EncodeGithubComGoogleGoGithubV32Github2(out, *v118)
 				}
 			}
 			out.RawByte(']')
@@ -7890,16 +14311,16 @@ func easyjson2a877177EncodeGithubComGoogleGoGithubV32Github(out *jwriter.Writer,
 			out.RawString(`null`)
 		} else {
 			out.RawByte('{')
-			v65First := true
-			for v65Name, v65Value := range *in.Permissions {
-				if v65First {
-					v65First = false
+			v119First := true
+			for v119Name, v119Value := range *in.Permissions {
+				if v119First {
+					v119First = false
 				} else {
 					out.RawByte(',')
 				}
-				out.String(string(v65Name))
+				out.String(string(v119Name))
 				out.RawByte(':')
-				out.Bool(bool(v65Value))
+				out.Bool(bool(v119Value))
 			}
 			out.RawByte('}')
 		}
@@ -7981,17 +14402,17 @@ func easyjson2a877177DecodeGithubComGoogleGoGithubV32Github2(in *jlexer.Lexer, o
 					out.Matches = (out.Matches)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v66 *github.Match
+					var v120 *github.Match
 					if in.IsNull() {
 						in.Skip()
-						v66 = nil
+						v120 = nil
 					} else {
-						if v66 == nil {
-							v66 = new(github.Match)
+						if v120 == nil {
+							v120 = new(github.Match)
 						}
-						easyjson2a877177DecodeGithubComGoogleGoGithubV32Github3(in, v66)
+						easyjson2a877177DecodeGithubComGoogleGoGithubV32Github3(in, v120)
 					}
-					out.Matches = append(out.Matches, v66)
+					out.Matches = append(out.Matches, v120)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -8056,14 +14477,14 @@ func easyjson2a877177EncodeGithubComGoogleGoGithubV32Github2(out *jwriter.Writer
 		}
 		{
 			out.RawByte('[')
-			for v67, v68 := range in.Matches {
-				if v67 > 0 {
+			for v121, v122 := range in.Matches {
+				if v121 > 0 {
 					out.RawByte(',')
 				}
-				if v68 == nil {
+				if v122 == nil {
 					out.RawString("null")
 				} else {
-					easyjson2a877177EncodeGithubComGoogleGoGithubV32Github3(out, *v68)
+					easyjson2a877177EncodeGithubComGoogleGoGithubV32Github3(out, *v122)
 				}
 			}
 			out.RawByte(']')
@@ -8116,9 +14537,9 @@ func easyjson2a877177DecodeGithubComGoogleGoGithubV32Github3(in *jlexer.Lexer, o
 					out.Indices = (out.Indices)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v69 int
-					v69 = int(in.Int())
-					out.Indices = append(out.Indices, v69)
+					var v123 int
+					v123 = int(in.Int())
+					out.Indices = append(out.Indices, v123)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -8153,11 +14574,11 @@ func easyjson2a877177EncodeGithubComGoogleGoGithubV32Github3(out *jwriter.Writer
 		}
 		{
 			out.RawByte('[')
-			for v70, v71 := range in.Indices {
-				if v70 > 0 {
+			for v124, v125 := range in.Indices {
+				if v124 > 0 {
 					out.RawByte(',')
 				}
-				out.Int(int(v71))
+				out.Int(int(v125))
 			}
 			out.RawByte(']')
 		}
@@ -8315,58 +14736,3 @@ func easyjson2a877177EncodeGithubComGoogleGoGithubV32Github1(out *jwriter.Writer
 	}
 	out.RawByte('}')
 }
-func easyjson2a877177Decode13(in *jlexer.Lexer, out *struct {
-	ID            string `json:"id"`
-	NameWithOwner string `json:"nameWithOwner"`
-}) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeString()
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "id":
-			out.ID = string(in.String())
-		case "nameWithOwner":
-			out.NameWithOwner = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjson2a877177Encode13(out *jwriter.Writer, in struct {
-	ID            string `json:"id"`
-	NameWithOwner string `json:"nameWithOwner"`
-}) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.ID))
-	}
-	{
-		const prefix string = ",\"nameWithOwner\":"
-		out.RawString(prefix)
-		out.String(string(in.NameWithOwner))
-	}
-	out.RawByte('}')
-}
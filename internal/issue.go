@@ -17,7 +17,8 @@ type label struct {
 }
 
 type labelNode struct {
-	Nodes []label `json:"nodes"`
+	PageInfo pageInfo `json:"pageInfo"`
+	Nodes    []label  `json:"nodes"`
 }
 
 type assigneesNode struct {
@@ -34,7 +35,9 @@ type comment struct {
 }
 
 type commentsNode struct {
-	Nodes []comment `json:"nodes"`
+	TotalCount int       `json:"totalCount"`
+	PageInfo   pageInfo  `json:"pageInfo"`
+	Nodes      []comment `json:"nodes"`
 }
 
 type issueMilestone struct {
@@ -49,21 +52,24 @@ type timelineItems struct {
 	Nodes []timelineItem `json:"nodes"`
 }
 type issue struct {
-	ID        string          `json:"id"`
-	CreatedAt time.Time       `json:"createdAt"`
-	UpdatedAt time.Time       `json:"updatedAt"`
-	ClosedAt  *time.Time      `json:"closedAt"`
-	State     string          `json:"state"`
-	URL       string          `json:"url"`
-	Title     string          `json:"title"`
-	Body      string          `json:"body"`
-	Closed    bool            `json:"closed"`
-	Labels    labelNode       `json:"labels"`
-	Comments  commentsNode    `json:"comments"`
-	Assignees assigneesNode   `json:"assignees"`
-	Author    author          `json:"author"`
-	Number    int             `json:"number"`
-	Milestone *issueMilestone `json:"milestone"`
+	ID        string             `json:"id"`
+	CreatedAt time.Time          `json:"createdAt"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+	ClosedAt  *time.Time         `json:"closedAt"`
+	State     string             `json:"state"`
+	URL       string             `json:"url"`
+	Title     string             `json:"title"`
+	Body      string             `json:"body"`
+	Closed    bool               `json:"closed"`
+	Labels    labelNode          `json:"labels"`
+	Comments  commentsNode       `json:"comments"`
+	Assignees assigneesNode      `json:"assignees"`
+	Author    author             `json:"author"`
+	Number    int                `json:"number"`
+	Milestone *issueMilestone    `json:"milestone"`
+	ClosedBy  issueReferences    `json:"closedByPullRequestsReferences"`
+	Timeline  issueTimelineItems `json:"timelineItems"`
+	Reason    string             `json:"stateReason"`
 }
 
 type issueNode struct {
@@ -127,53 +133,67 @@ func (g *GithubIntegration) processDefaultIssueType(logger sdk.Logger, pipe sdk.
 	return nil
 }
 
-func (l label) ToModel(logger sdk.Logger, state sdk.State, customerID string, integrationInstanceID string, historical bool) (*sdk.WorkIssueType, error) {
-	key := issueTypeCacheKeyPrefix + l.ID
+func (l label) ToModel(logger sdk.Logger, state sdk.State, issueTypes issueTypeMapping, customerID string, integrationInstanceID string, historical bool) (*sdk.WorkIssueType, error) {
+	rule := issueTypes.match(l.Name)
+	if rule == nil {
+		return nil, nil
+	}
+	key := issueTypeCacheKeyPrefix + issueTypes.hash() + "_" + l.ID
 	if historical || !state.Exists(key) {
-		switch l.Name {
-		case "bug":
-			var t sdk.WorkIssueType
-			t.CustomerID = customerID
-			t.IntegrationInstanceID = sdk.StringPointer(integrationInstanceID)
-			t.RefID = l.ID
-			t.RefType = refType
-			t.Name = "Bug"
-			t.Description = sdk.StringPointer(l.Description)
-			t.MappedType = sdk.WorkIssueTypeMappedTypeBug
-			t.IconURL = sdk.StringPointer("data:image/svg+xml,%3Csvg aria-hidden='true' focusable='false' data-prefix='fas' data-icon='bug' class='svg-inline--fa fa-bug fa-w-16' role='img' xmlns='http://www.w3.org/2000/svg' viewBox='0 0 512 512'%3E%3Cpath fill='currentColor' d='M511.988 288.9c-.478 17.43-15.217 31.1-32.653 31.1H424v16c0 21.864-4.882 42.584-13.6 61.145l60.228 60.228c12.496 12.497 12.496 32.758 0 45.255-12.498 12.497-32.759 12.496-45.256 0l-54.736-54.736C345.886 467.965 314.351 480 280 480V236c0-6.627-5.373-12-12-12h-24c-6.627 0-12 5.373-12 12v244c-34.351 0-65.886-12.035-90.636-32.108l-54.736 54.736c-12.498 12.497-32.759 12.496-45.256 0-12.496-12.497-12.496-32.758 0-45.255l60.228-60.228C92.882 378.584 88 357.864 88 336v-16H32.666C15.23 320 .491 306.33.013 288.9-.484 270.816 14.028 256 32 256h56v-58.745l-46.628-46.628c-12.496-12.497-12.496-32.758 0-45.255 12.498-12.497 32.758-12.497 45.256 0L141.255 160h229.489l54.627-54.627c12.498-12.497 32.758-12.497 45.256 0 12.496 12.497 12.496 32.758 0 45.255L424 197.255V256h56c17.972 0 32.484 14.816 31.988 32.9zM257 0c-61.856 0-112 50.144-112 112h224C369 50.144 318.856 0 257 0z'%3E%3C/path%3E%3C/svg%3E")
-			t.ID = sdk.NewWorkIssueTypeID(customerID, refType, l.ID)
-			err := state.Set(key, t.ID)
-			sdk.LogDebug(logger, "creating issue type", "name", t.Name, "id", t.RefID, "err", err)
-			return &t, err
-		case "enhancement":
-			var t sdk.WorkIssueType
-			t.CustomerID = customerID
-			t.IntegrationInstanceID = sdk.StringPointer(integrationInstanceID)
-			t.RefID = l.ID
-			t.RefType = refType
-			t.Name = "Enhancement"
-			t.MappedType = sdk.WorkIssueTypeMappedTypeEnhancement
-			t.IconURL = sdk.StringPointer("data:image/svg+xml,%3Csvg aria-hidden='true' focusable='false' data-prefix='fas' data-icon='exclamation-circle' class='svg-inline--fa fa-exclamation-circle fa-w-16' role='img' xmlns='http://www.w3.org/2000/svg' viewBox='0 0 512 512'%3E%3Cpath fill='currentColor' d='M504 256c0 136.997-111.043 248-248 248S8 392.997 8 256C8 119.083 119.043 8 256 8s248 111.083 248 248zm-248 50c-25.405 0-46 20.595-46 46s20.595 46 46 46 46-20.595 46-46-20.595-46-46-46zm-43.673-165.346l7.418 136c.347 6.364 5.609 11.346 11.982 11.346h48.546c6.373 0 11.635-4.982 11.982-11.346l7.418-136c.375-6.874-5.098-12.654-11.982-12.654h-63.383c-6.884 0-12.356 5.78-11.981 12.654z'%3E%3C/path%3E%3C/svg%3E")
-			t.Description = sdk.StringPointer(l.Description)
-			t.ID = sdk.NewWorkIssueTypeID(customerID, refType, l.ID)
-			err := state.Set(key, t.ID)
-			sdk.LogDebug(logger, "creating issue type", "name", t.Name, "id", t.RefID, "err", err)
-			return &t, err
-		}
+		var t sdk.WorkIssueType
+		t.CustomerID = customerID
+		t.IntegrationInstanceID = sdk.StringPointer(integrationInstanceID)
+		t.RefID = l.ID
+		t.RefType = refType
+		t.Name = rule.typeName(l.Name)
+		t.Description = sdk.StringPointer(l.Description)
+		t.MappedType = rule.mappedType
+		t.IconURL = rule.icon()
+		t.ID = sdk.NewWorkIssueTypeID(customerID, refType, l.ID)
+		err := state.Set(key, t.ID)
+		sdk.LogDebug(logger, "creating issue type", "name", t.Name, "id", t.RefID, "err", err)
+		return &t, err
 	}
 	return nil, nil
 }
 
-func setIssueType(issue *sdk.WorkIssue, labels []label) {
+// fetchIssueLabels returns the remaining labels of the issue after the cursor
+func (g *GithubIntegration) fetchIssueLabels(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, issueID string, cursor string) ([]label, error) {
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"first": 100,
+		"after": cursor,
+	}
+	exec := g.newQueryExecutor(logger, client, control)
+	labels := make([]label, 0)
+	for {
+		sdk.LogDebug(logger, "running fetch issue labels", "id", issueID, "after", variables["after"])
+		var result struct {
+			RateLimit rateLimit `json:"rateLimit"`
+			Node      struct {
+				Labels labelNode `json:"labels"`
+			} `json:"node"`
+		}
+		if err := exec.Query(issueLabelsPagedQuery, variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching issue labels: %w", err)
+		}
+		labels = append(labels, result.Node.Labels.Nodes...)
+		if !result.Node.Labels.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return nil, err
+		}
+		variables["after"] = result.Node.Labels.PageInfo.EndCursor
+	}
+	return labels, nil
+}
+
+func setIssueType(issue *sdk.WorkIssue, issueTypes issueTypeMapping, labels []label) {
 	for _, label := range labels {
-		switch label.Name {
-		case "bug":
-			issue.Type = "Bug"
-			issue.TypeID = label.ID
-			return
-		case "enhancement":
-			issue.Type = "Enhancement"
-			issue.TypeID = label.ID
+		if rule := issueTypes.match(label.Name); rule != nil {
+			issue.Type = rule.typeName(label.Name)
+			issue.TypeID = sdk.NewWorkIssueTypeID(issue.CustomerID, refType, label.ID)
 			return
 		}
 	}
@@ -181,7 +201,7 @@ func setIssueType(issue *sdk.WorkIssue, labels []label) {
 	issue.TypeID = sdk.NewWorkIssueTypeID(issue.CustomerID, refType, defaultIssueTypeRefID)
 }
 
-func (g *GithubIntegration) fromIssueEvent(logger sdk.Logger, userManager *UserManager, integrationInstanceID string, customerID string, event *github.IssuesEvent) (*sdk.WorkIssue, error) {
+func (g *GithubIntegration) fromIssueEvent(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, issueTypes issueTypeMapping, priorities issuePriorityMapping, integrationInstanceID string, customerID string, event *github.IssuesEvent) (*sdk.WorkIssue, error) {
 	var issue issue
 	theIssue := event.Issue
 	issue.ID = theIssue.GetNodeID()
@@ -192,7 +212,7 @@ func (g *GithubIntegration) fromIssueEvent(logger sdk.Logger, userManager *UserM
 	issue.URL = theIssue.GetHTMLURL()
 	issue.Title = theIssue.GetTitle()
 	issue.Body = theIssue.GetBody()
-	issue.Closed = theIssue.GetState() == "CLOSED"
+	issue.Closed = strings.EqualFold(theIssue.GetState(), "CLOSED")
 	issue.Number = theIssue.GetNumber()
 	issue.Author = userToAuthor(theIssue.User)
 	issue.Assignees = assigneesNode{Nodes: make([]author, 0)}
@@ -206,11 +226,35 @@ func (g *GithubIntegration) fromIssueEvent(logger sdk.Logger, userManager *UserM
 	for _, l := range theIssue.Labels {
 		issue.Labels.Nodes = append(issue.Labels.Nodes, label{ID: l.GetNodeID(), Name: l.GetName()})
 	}
+	if event.GetAction() != "deleted" {
+		// the webhook doesn't have the pull requests which close the issue or the reason it was closed
+		var result struct {
+			Node struct {
+				ClosedBy issueReferences `json:"closedByPullRequestsReferences"`
+				Reason   string          `json:"stateReason"`
+			} `json:"node"`
+		}
+		if err := g.newQueryExecutor(logger, client, control).Query(issueClosedByQuery, map[string]interface{}{"id": issue.ID}, &result); err != nil {
+			return nil, fmt.Errorf("error fetching pull requests which close the issue: %w", err)
+		}
+		issue.ClosedBy = result.Node.ClosedBy
+		issue.Reason = result.Node.Reason
+	}
 	projectID := sdk.NewWorkProjectID(customerID, event.Repo.GetNodeID(), refType)
-	return issue.ToModel(logger, userManager, customerID, integrationInstanceID, event.Repo.GetFullName(), projectID)
+	result, err := issue.ToModel(logger, userManager, issueTypes, priorities, customerID, integrationInstanceID, event.Repo.GetFullName(), projectID)
+	if err != nil {
+		return nil, err
+	}
+	if event.GetAction() != "deleted" {
+		// the webhook doesn't have the timeline so fetch it all
+		if err := g.setIssueChangeLog(logger, client, userManager, control, result, issueTimelineItems{PageInfo: pageInfo{HasNextPage: true}}); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
 }
 
-func (i issue) ToModel(logger sdk.Logger, userManager *UserManager, customerID string, integrationInstanceID string, repoName, projectID string) (*sdk.WorkIssue, error) {
+func (i issue) ToModel(logger sdk.Logger, userManager *UserManager, issueTypes issueTypeMapping, priorities issuePriorityMapping, customerID string, integrationInstanceID string, repoName, projectID string) (*sdk.WorkIssue, error) {
 	var issue sdk.WorkIssue
 	issue.CustomerID = customerID
 	issue.IntegrationInstanceID = sdk.StringPointer(integrationInstanceID)
@@ -237,7 +281,9 @@ func (i issue) ToModel(logger sdk.Logger, userManager *UserManager, customerID s
 		issue.Status = "Open"
 	}
 	issue.StatusID = sdk.NewWorkIssueStatusID(customerID, refType, issue.Status)
-	setIssueType(&issue, i.Labels.Nodes)
+	setIssueType(&issue, issueTypes, i.Labels.Nodes)
+	setIssuePriority(&issue, priorities, i.Labels.Nodes)
+	setIssueResolution(&issue, i.Closed, i.Reason, i.Labels.Nodes)
 	issue.CreatorRefID = i.Author.RefID(customerID)
 	issue.ReporterRefID = i.Author.RefID(customerID)
 	if err := userManager.emitAuthor(logger, i.Author); err != nil {
@@ -252,6 +298,12 @@ func (i issue) ToModel(logger sdk.Logger, userManager *UserManager, customerID s
 	if i.Milestone != nil {
 		issue.ParentID = sdk.NewWorkIssueID(customerID, i.Milestone.ID, refType)
 	}
+	// only the pull requests which github knows close the issue are linked from the issue. the references parsed from
+	// the pull request texts are only set on the pull request since a pull request webhook doesn't rewrite the issues
+	issue.LinkedIssues = make([]sdk.WorkIssueLinkedIssues, 0)
+	for _, ref := range i.ClosedBy.Nodes {
+		issue.LinkedIssues = append(issue.LinkedIssues, ref.ToLinkedIssue(customerID))
+	}
 	issue.Transitions = make([]sdk.WorkIssueTransitions, 0)
 	if i.Closed {
 		issue.Transitions = append(issue.Transitions, sdk.WorkIssueTransitions{
@@ -267,8 +319,6 @@ func (i issue) ToModel(logger sdk.Logger, userManager *UserManager, customerID s
 	return &issue, nil
 }
 
-// TODO: linked_issues for PRs which are linked to an issue
-
 func (g *GithubIntegration) fromIssueCommentEvent(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, customerID string, integrationInstanceID string, commentEvent *github.IssueCommentEvent) (*sdk.WorkIssueComment, error) {
 	var comment comment
 	theComment := commentEvent.GetComment()
@@ -328,6 +378,12 @@ const createIssueQuery = `mutation createIssue($repositoryID: ID!,$title: String
 		milestone {
 			id
 		}
+		labels(first: 100) {
+			nodes {
+				id
+				name
+			}
+		}
 	  }
 	}
   }`
@@ -357,11 +413,17 @@ const createIssueQueryWithLabels = `mutation createIssue($repositoryID: ID!, $ti
 		milestone {
 			id
 		}
+		labels(first: 100) {
+			nodes {
+				id
+				name
+			}
+		}
 	  }
 	}
   }`
 
-func (g *GithubIntegration) createIssue(logger sdk.Logger, userManager *UserManager, mutation *sdk.WorkIssueCreateMutation, user sdk.MutationUser) (*sdk.MutationResponse, error) {
+func (g *GithubIntegration) createIssue(logger sdk.Logger, userManager *UserManager, issueTypes issueTypeMapping, priorities issuePriorityMapping, mutation *sdk.WorkIssueCreateMutation, user sdk.MutationUser) (*sdk.MutationResponse, error) {
 
 	var c sdk.Config
 	c.APIKeyAuth = user.APIKeyAuth
@@ -385,7 +447,7 @@ func (g *GithubIntegration) createIssue(logger sdk.Logger, userManager *UserMana
 
 	switch issueType {
 	case "bug":
-		workIssue, err = createIssue(logger, client, input, userManager, "")
+		workIssue, err = createIssue(logger, client, input, userManager, issueTypes, priorities, "")
 		if err != nil {
 			return nil, err
 		}
@@ -395,7 +457,7 @@ func (g *GithubIntegration) createIssue(logger sdk.Logger, userManager *UserMana
 			return nil, err
 		}
 	default:
-		workIssue, err = createIssue(logger, client, input, userManager, issueType)
+		workIssue, err = createIssue(logger, client, input, userManager, issueTypes, priorities, issueType)
 		if err != nil {
 			return nil, err
 		}
@@ -408,7 +470,7 @@ func (g *GithubIntegration) createIssue(logger sdk.Logger, userManager *UserMana
 	}, nil
 }
 
-func createIssue(logger sdk.Logger, client sdk.GraphQLClient, input map[string]interface{}, userManager *UserManager, labelID string) (*sdk.WorkIssue, error) {
+func createIssue(logger sdk.Logger, client sdk.GraphQLClient, input map[string]interface{}, userManager *UserManager, issueTypes issueTypeMapping, priorities issuePriorityMapping, labelID string) (*sdk.WorkIssue, error) {
 	var response struct {
 		Data struct {
 			CreateIssue struct {
@@ -436,7 +498,7 @@ func createIssue(logger sdk.Logger, client sdk.GraphQLClient, input map[string]i
 		return nil, fmt.Errorf("error creating issue %v", response.Errors)
 	}
 
-	return response.Data.CreateIssue.Issue.toModel(logger, userManager, userManager.instanceid, userManager.customerID)
+	return response.Data.CreateIssue.Issue.toModel(logger, userManager, issueTypes, priorities, userManager.instanceid, userManager.customerID)
 }
 
 func getRefID(val sdk.MutationFieldValue) (string, error) {
@@ -509,9 +571,10 @@ type CreateIssue struct {
 	} `json:"repository"`
 	CreatedAt time.Time    `json:"createdAt"`
 	Author    *github.User `json:"author"`
+	Labels    labelNode    `json:"labels"`
 }
 
-func (c *CreateIssue) toModel(logger sdk.Logger, userManager *UserManager, integrationInstanceID string, customerID string) (*sdk.WorkIssue, error) {
+func (c *CreateIssue) toModel(logger sdk.Logger, userManager *UserManager, issueTypes issueTypeMapping, priorities issuePriorityMapping, integrationInstanceID string, customerID string) (*sdk.WorkIssue, error) {
 
 	var issue issue
 
@@ -524,8 +587,9 @@ func (c *CreateIssue) toModel(logger sdk.Logger, userManager *UserManager, integ
 	issue.Body = c.Body
 	issue.Number = c.Number
 	issue.Author = userToAuthor(c.Author)
+	issue.Labels = c.Labels
 	projectID := sdk.NewWorkProjectID(customerID, c.Repository.ID, refType)
-	return issue.ToModel(logger, userManager, customerID, integrationInstanceID, c.Repository.NameWithOwner, projectID)
+	return issue.ToModel(logger, userManager, issueTypes, priorities, customerID, integrationInstanceID, c.Repository.NameWithOwner, projectID)
 
 }
 
@@ -552,12 +616,18 @@ const updateIssueQuery = `mutation updateIssue($id: ID!, %s) {
 			  milestone {
 				  id
 			  }
+			  labels(first: 100) {
+				  nodes {
+				  	id
+				  	name
+				  }
+			  }
 		  }
 	}
   }
   `
 
-func getUpdateQuery(includeTitle, includeMilestoneID, includeAssigneeIDs bool) string {
+func getUpdateQuery(includeTitle, includeMilestoneID, includeAssigneeIDs, includeLabelIDs bool) string {
 
 	filters := make([]string, 0)
 	input := make([]string, 0)
@@ -577,6 +647,11 @@ func getUpdateQuery(includeTitle, includeMilestoneID, includeAssigneeIDs bool) s
 		input = append(input, "assigneeIds: $assignees")
 	}
 
+	if includeLabelIDs {
+		filters = append(filters, "$labels: [ID!]")
+		input = append(input, "labelIds: $labels")
+	}
+
 	return fmt.Sprintf(updateIssueQuery, strings.Join(filters, ", "), strings.Join(input, ", "))
 }
 
@@ -591,7 +666,7 @@ type issueUpdateResponse struct {
 	} `json:"errors"`
 }
 
-func (g *GithubIntegration) UpdateIssue(logger sdk.Logger, userManager *UserManager, issueRefID string, mutation *sdk.WorkIssueUpdateMutation, user sdk.MutationUser) (*sdk.MutationResponse, error) {
+func (g *GithubIntegration) UpdateIssue(logger sdk.Logger, control sdk.Control, userManager *UserManager, issueTypes issueTypeMapping, priorities issuePriorityMapping, issueRefID string, mutation *sdk.WorkIssueUpdateMutation, user sdk.MutationUser) (*sdk.MutationResponse, error) {
 
 	var c sdk.Config
 	c.APIKeyAuth = user.APIKeyAuth
@@ -606,10 +681,19 @@ func (g *GithubIntegration) UpdateIssue(logger sdk.Logger, userManager *UserMana
 	var response *issueUpdateResponse
 
 	input, hasMutation := makeIssueUpdate(mutation)
+	if mutation.Set.Priority != nil {
+		// github doesn't have priorities so swap the priority label
+		labels, err := g.issuePriorityLabels(logger, client, control, priorities, issueRefID, mutation.Set.Priority)
+		if err != nil {
+			return nil, err
+		}
+		input["labels"] = labels
+		hasMutation = true
+	}
 	if hasMutation {
 		input["id"] = issueRefID
 
-		query := getUpdateQuery(mutation.Set.Title != nil, mutation.Set.Epic != nil, mutation.Set.AssigneeRefID != nil)
+		query := getUpdateQuery(mutation.Set.Title != nil, mutation.Set.Epic != nil, mutation.Set.AssigneeRefID != nil, mutation.Set.Priority != nil)
 
 		sdk.LogDebug(logger, "sending issue update mutation", "input", input, "user", user.RefID)
 		if err := client.Query(query, input, &response); err != nil {
@@ -624,13 +708,13 @@ func (g *GithubIntegration) UpdateIssue(logger sdk.Logger, userManager *UserMana
 
 	if mutation.Unset.Assignee || mutation.Unset.Epic {
 		var err error
-		response, err = unsetIssueFieldsIfAny(logger, client, issueRefID, mutation)
+		response, err = g.unsetIssueFieldsIfAny(logger, client, control, issueRefID, mutation)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	workIssue, err = response.Data.CreateIssue.Issue.toModel(logger, userManager, userManager.instanceid, userManager.customerID)
+	workIssue, err = response.Data.CreateIssue.Issue.toModel(logger, userManager, issueTypes, priorities, userManager.instanceid, userManager.customerID)
 	if err != nil {
 		return nil, err
 	}
@@ -687,12 +771,18 @@ const unsetIssueQuery = `mutation updateIssue($id: ID!){
 			milestone {
 				id
 			}
+			labels(first: 100) {
+				nodes {
+					id
+					name
+				}
+			}
 		}
 	}
   }
 `
 
-func unsetIssueFieldsIfAny(logger sdk.Logger, client sdk.GraphQLClient, issueRefID string, mutation *sdk.WorkIssueUpdateMutation) (*issueUpdateResponse, error) {
+func (g *GithubIntegration) unsetIssueFieldsIfAny(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, issueRefID string, mutation *sdk.WorkIssueUpdateMutation) (*issueUpdateResponse, error) {
 
 	var filters string
 
@@ -713,7 +803,8 @@ func unsetIssueFieldsIfAny(logger sdk.Logger, client sdk.GraphQLClient, issueRef
 
 	var r issueUpdateResponse
 
-	err := client.Query(query, input, &r)
+	// unsetting is idempotent so it's safe to retry
+	err := g.newQueryExecutor(logger, client, control).Query(query, input, &r)
 	if err != nil {
 		return nil, err
 	}
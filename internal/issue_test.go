@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueIssueCommentsJob(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	client := &mockPagedGraphQLClient{
+		responses: []string{
+			`{"rateLimit":{"limit":5000,"remaining":4999},"repository":{"issue":{"comments":{"pageInfo":{"hasPreviousPage":true,"startCursor":"c2"},"nodes":[{"id":"IC_3","body":"third","author":{"type":"User","id":"U_1","login":"jhaynie"}}]}}}}`,
+			`{"rateLimit":{"limit":5000,"remaining":4998},"repository":{"issue":{"comments":{"pageInfo":{"hasPreviousPage":false,"startCursor":"c1"},"nodes":[{"id":"IC_1","body":"first","author":{"type":"User","id":"U_1","login":"jhaynie"}},{"id":"IC_2","body":"second","author":{"type":"Bot","login":"dependabot"}}]}}}}`,
+		},
+	}
+	pipe := &mockPipe{}
+	job := g.queueIssueCommentsJob(sdk.NewNoOpTestLogger(), client, newMockUserManager(pipe), "pinpt/agent", "P_1", "I_1", 10, "c3")
+	assert.NoError(job(&mockExport{}, pipe))
+	assert.Len(client.variables, 2)
+	assert.Equal("c3", client.variables[0]["before"])
+	assert.Equal("c2", client.variables[1]["before"])
+	assert.Equal("pinpt", client.variables[1]["owner"])
+	assert.Equal("agent", client.variables[1]["name"])
+	assert.Equal(10, client.variables[1]["number"])
+	comments := make([]string, 0)
+	var users int
+	for _, record := range pipe.records {
+		switch r := record.(type) {
+		case *sdk.WorkIssueComment:
+			assert.Equal("I_1", r.IssueID)
+			assert.Equal("P_1", r.ProjectID)
+			comments = append(comments, r.RefID)
+		case *sdk.SourceCodeUser:
+			users++
+		}
+	}
+	assert.Equal([]string{"IC_3", "IC_1", "IC_2"}, comments)
+	assert.Equal(1, users)
+}
+
+func TestFetchIssueLabels(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	client := &mockPagedGraphQLClient{
+		responses: []string{
+			`{"rateLimit":{"limit":5000,"remaining":4999},"node":{"labels":{"pageInfo":{"hasNextPage":true,"endCursor":"l2"},"nodes":[{"id":"L_1","name":"bug"}]}}}`,
+			`{"rateLimit":{"limit":5000,"remaining":4998},"node":{"labels":{"pageInfo":{"hasNextPage":false},"nodes":[{"id":"L_2","name":"P1"}]}}}`,
+		},
+	}
+	labels, err := g.fetchIssueLabels(sdk.NewNoOpTestLogger(), client, &mockControl{}, "I_1", "l1")
+	assert.NoError(err)
+	assert.Len(labels, 2)
+	assert.Equal("P1", labels[1].Name)
+	assert.Equal("l1", client.variables[0]["after"])
+	assert.Equal("l2", client.variables[1]["after"])
+	assert.Equal("I_1", client.variables[1]["id"])
+}
+
+func TestFetchAllRepoIssuesPageSize(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	client := &mockPagedGraphQLClient{
+		responses: []string{
+			`{"rateLimit":{"limit":5000,"remaining":4999},"repository":{"issues":{"pageInfo":{"hasNextPage":true,"startCursor":"i1","endCursor":"i2"},"nodes":[]}}}`,
+			`{"rateLimit":{"limit":5000,"remaining":4998},"repository":{"issues":{"pageInfo":{"hasNextPage":false},"nodes":[]}}}`,
+		},
+	}
+	pipe := &mockPipe{}
+	export := &mockExport{state: &mockState{values: make(map[string]string)}, pipe: pipe}
+	queue := func(j checkpointJob) error { return nil }
+	assert.NoError(g.fetchAllRepoIssues(sdk.NewNoOpTestLogger(), client, newMockUserManager(pipe), nil, nil, nil, &exportErrors{}, export, "pinpt/agent", "R_1", true, queue))
+	assert.Len(client.variables, 2)
+	assert.Equal(defaultIssuePageSize, client.variables[0]["first"])
+	assert.Equal(defaultIssuePageSize, client.variables[1]["first"])
+	assert.Equal("i2", client.variables[1]["after"])
+}
@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+const (
+	bugIssueTypeIcon         = "data:image/svg+xml,%3Csvg aria-hidden='true' focusable='false' data-prefix='fas' data-icon='bug' class='svg-inline--fa fa-bug fa-w-16' role='img' xmlns='http://www.w3.org/2000/svg' viewBox='0 0 512 512'%3E%3Cpath fill='currentColor' d='M511.988 288.9c-.478 17.43-15.217 31.1-32.653 31.1H424v16c0 21.864-4.882 42.584-13.6 61.145l60.228 60.228c12.496 12.497 12.496 32.758 0 45.255-12.498 12.497-32.759 12.496-45.256 0l-54.736-54.736C345.886 467.965 314.351 480 280 480V236c0-6.627-5.373-12-12-12h-24c-6.627 0-12 5.373-12 12v244c-34.351 0-65.886-12.035-90.636-32.108l-54.736 54.736c-12.498 12.497-32.759 12.496-45.256 0-12.496-12.497-12.496-32.758 0-45.255l60.228-60.228C92.882 378.584 88 357.864 88 336v-16H32.666C15.23 320 .491 306.33.013 288.9-.484 270.816 14.028 256 32 256h56v-58.745l-46.628-46.628c-12.496-12.497-12.496-32.758 0-45.255 12.498-12.497 32.758-12.497 45.256 0L141.255 160h229.489l54.627-54.627c12.498-12.497 32.758-12.497 45.256 0 12.496 12.497 12.496 32.758 0 45.255L424 197.255V256h56c17.972 0 32.484 14.816 31.988 32.9zM257 0c-61.856 0-112 50.144-112 112h224C369 50.144 318.856 0 257 0z'%3E%3C/path%3E%3C/svg%3E"
+	enhancementIssueTypeIcon = "data:image/svg+xml,%3Csvg aria-hidden='true' focusable='false' data-prefix='fas' data-icon='exclamation-circle' class='svg-inline--fa fa-exclamation-circle fa-w-16' role='img' xmlns='http://www.w3.org/2000/svg' viewBox='0 0 512 512'%3E%3Cpath fill='currentColor' d='M504 256c0 136.997-111.043 248-248 248S8 392.997 8 256C8 119.083 119.043 8 256 8s248 111.083 248 248zm-248 50c-25.405 0-46 20.595-46 46s20.595 46 46 46 46-20.595 46-46-20.595-46-46-46zm-43.673-165.346l7.418 136c.347 6.364 5.609 11.346 11.982 11.346h48.546c6.373 0 11.635-4.982 11.982-11.346l7.418-136c.375-6.874-5.098-12.654-11.982-12.654h-63.383c-6.884 0-12.356 5.78-11.981 12.654z'%3E%3C/path%3E%3C/svg%3E"
+)
+
+// issueTypeRule maps the labels which match it to an issue type. a rule matches a label by its name, ignoring case, or
+// by a regular expression
+type issueTypeRule struct {
+	Label   string `json:"label"`
+	Pattern string `json:"pattern"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+
+	re         *regexp.Regexp
+	mappedType sdk.WorkIssueTypeMappedType
+}
+
+type issueTypeMapping []*issueTypeRule
+
+// hash returns a hash of the rules which is part of the cache keys so the issue types are written again when the
+// mapping changes
+func (m issueTypeMapping) hash() string {
+	buf, _ := json.Marshal(m)
+	return sdk.Hash(string(buf))
+}
+
+// defaultIssueTypeMapping is the mapping for github's built-in labels used when the instance doesn't configure one
+var defaultIssueTypeMapping = issueTypeMapping{
+	{Label: "bug", Name: "Bug", Type: "bug", mappedType: sdk.WorkIssueTypeMappedTypeBug},
+	{Label: "enhancement", Name: "Enhancement", Type: "enhancement", mappedType: sdk.WorkIssueTypeMappedTypeEnhancement},
+}
+
+var issueMappedTypes = map[string]sdk.WorkIssueTypeMappedType{
+	"task":        sdk.WorkIssueTypeMappedTypeTask,
+	"story":       sdk.WorkIssueTypeMappedTypeStory,
+	"bug":         sdk.WorkIssueTypeMappedTypeBug,
+	"epic":        sdk.WorkIssueTypeMappedTypeEpic,
+	"subtask":     sdk.WorkIssueTypeMappedTypeSubtask,
+	"enhancement": sdk.WorkIssueTypeMappedTypeEnhancement,
+}
+
+func (r *issueTypeRule) matches(labelName string) bool {
+	if r.re != nil {
+		return r.re.MatchString(labelName)
+	}
+	return strings.EqualFold(r.Label, labelName)
+}
+
+// typeName returns the name of the issue type for the label, which is the label name if the rule doesn't have one
+func (r *issueTypeRule) typeName(labelName string) string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return labelName
+}
+
+func (r *issueTypeRule) icon() *string {
+	switch r.mappedType {
+	case sdk.WorkIssueTypeMappedTypeBug:
+		return sdk.StringPointer(bugIssueTypeIcon)
+	case sdk.WorkIssueTypeMappedTypeEnhancement:
+		return sdk.StringPointer(enhancementIssueTypeIcon)
+	}
+	return nil
+}
+
+// match returns the first rule which matches the label or nil if the label isn't an issue type
+func (m issueTypeMapping) match(labelName string) *issueTypeRule {
+	for _, rule := range m {
+		if rule.matches(labelName) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// parseIssueTypeMapping parses the mapping from the config which can either be a list of rules or a JSON string of them
+func parseIssueTypeMapping(val interface{}) (issueTypeMapping, error) {
+	var buf []byte
+	if str, ok := val.(string); ok {
+		buf = []byte(str)
+	} else {
+		b, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		buf = b
+	}
+	var mapping issueTypeMapping
+	if err := json.Unmarshal(buf, &mapping); err != nil {
+		return nil, err
+	}
+	for i, rule := range mapping {
+		if rule == nil || (rule.Label == "" && rule.Pattern == "") {
+			return nil, fmt.Errorf("issue type rule %d must have a label or a pattern", i)
+		}
+		if rule.Pattern != "" {
+			re, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("issue type rule %d has an invalid pattern: %w", i, err)
+			}
+			rule.re = re
+		}
+		mappedType, ok := issueMappedTypes[strings.ToLower(rule.Type)]
+		if !ok {
+			return nil, fmt.Errorf("issue type rule %d has an invalid type: %s", i, rule.Type)
+		}
+		rule.mappedType = mappedType
+	}
+	return mapping, nil
+}
+
+// getIssueTypeMapping returns the label to issue type mapping configured for the instance or the default mapping
+func (g *GithubIntegration) getIssueTypeMapping(config sdk.Config) (issueTypeMapping, error) {
+	ok, val := config.Get("issue_types")
+	if !ok {
+		ok, val = g.config.Get("issue_types")
+	}
+	if !ok || val == nil {
+		return defaultIssueTypeMapping, nil
+	}
+	mapping, err := parseIssueTypeMapping(val)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing issue_types config: %w", err)
+	}
+	return mapping, nil
+}
@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIssueTypeMapping(t *testing.T) {
+	assert := assert.New(t)
+	mapping, err := parseIssueTypeMapping(`[{"label":"Type: Bug","name":"Bug","type":"bug"},{"pattern":"^kind/","type":"Story"}]`)
+	assert.NoError(err)
+	rule := mapping.match("type: bug")
+	assert.NotNil(rule)
+	assert.Equal("Bug", rule.typeName("type: bug"))
+	assert.Equal(sdk.WorkIssueTypeMappedTypeBug, rule.mappedType)
+	rule = mapping.match("kind/feature")
+	assert.NotNil(rule)
+	assert.Equal("kind/feature", rule.typeName("kind/feature"))
+	assert.Equal(sdk.WorkIssueTypeMappedTypeStory, rule.mappedType)
+	assert.Nil(mapping.match("bug"))
+
+	mapping, err = parseIssueTypeMapping([]interface{}{map[string]interface{}{"label": "chore", "type": "task"}})
+	assert.NoError(err)
+	assert.NotNil(mapping.match("chore"))
+
+	_, err = parseIssueTypeMapping(`[{"label":"chore","type":"other"}]`)
+	assert.Error(err)
+	_, err = parseIssueTypeMapping(`[{"pattern":"(","type":"task"}]`)
+	assert.Error(err)
+	_, err = parseIssueTypeMapping(`[{"type":"task"}]`)
+	assert.Error(err)
+}
+
+func TestDefaultIssueTypeMapping(t *testing.T) {
+	assert := assert.New(t)
+	var issue sdk.WorkIssue
+	setIssueType(&issue, defaultIssueTypeMapping, []label{{ID: "1", Name: "question"}, {ID: "2", Name: "enhancement"}})
+	assert.Equal("Enhancement", issue.Type)
+	setIssueType(&issue, defaultIssueTypeMapping, []label{{ID: "1", Name: "question"}})
+	assert.Equal(defaultIssueTypeName, issue.Type)
+}
+
+func TestLabelToModelMappingChange(t *testing.T) {
+	assert := assert.New(t)
+	state := &mockState{}
+	l := label{ID: "L_1", Name: "chore"}
+	before, err := parseIssueTypeMapping(`[{"label":"chore","name":"Chore","type":"task"}]`)
+	assert.NoError(err)
+	t1, err := l.ToModel(nil, state, before, "1234", "5678", false)
+	assert.NoError(err)
+	assert.Equal("Chore", t1.Name)
+	t1, err = l.ToModel(nil, state, before, "1234", "5678", false)
+	assert.NoError(err)
+	assert.Nil(t1)
+	after, err := parseIssueTypeMapping(`[{"label":"chore","name":"Maintenance","type":"task"}]`)
+	assert.NoError(err)
+	t2, err := l.ToModel(nil, state, after, "1234", "5678", false)
+	assert.NoError(err)
+	assert.Equal("Maintenance", t2.Name)
+}
@@ -0,0 +1,177 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+// closingKeywords matches the keywords github uses to close an issue from a pull request followed by a reference to
+// an issue in the same repo (#123) or in another repo (owner/repo#123)
+var closingKeywords = regexp.MustCompile(`(?i)\b(?:close[sd]?|fix(?:e[sd])?|resolve[sd]?):?\s+((?:[\w.-]+/[\w.-]+)?#\d+)\b`)
+
+// maxIssueReferences is the most issue references we will look up for a pull request
+const maxIssueReferences = 25
+
+type issueReference struct {
+	ID         string `json:"id"`
+	Number     int    `json:"number"`
+	URL        string `json:"url"`
+	Repository struct {
+		ID            string `json:"id"`
+		NameWithOwner string `json:"nameWithOwner"`
+	} `json:"repository"`
+}
+
+type issueReferences struct {
+	Nodes []issueReference `json:"nodes"`
+}
+
+// Identifier returns the issue as owner/repo#number
+func (r issueReference) Identifier() string {
+	return fmt.Sprintf("%s#%d", r.Repository.NameWithOwner, r.Number)
+}
+
+// ToLinkedIssue returns the link from an issue to the pull request which closes it
+func (r issueReference) ToLinkedIssue(customerID string) sdk.WorkIssueLinkedIssues {
+	repoID := sdk.NewSourceCodeRepoID(customerID, r.Repository.ID, refType)
+	return sdk.WorkIssueLinkedIssues{
+		RefID:         r.ID,
+		RefType:       refType,
+		PullRequestID: sdk.NewSourceCodePullRequestID(customerID, r.ID, refType, repoID),
+		Identifier:    r.Identifier(),
+		URL:           r.URL,
+		LinkType:      sdk.WorkIssueLinkedIssuesLinkTypeClosedBy,
+	}
+}
+
+// parseClosingReferences returns the issues referenced with a closing keyword in the texts as owner/repo#number
+func parseClosingReferences(repoName string, texts ...string) []string {
+	found := make(map[string]bool)
+	identifiers := make([]string, 0)
+	for _, text := range texts {
+		for _, match := range closingKeywords.FindAllStringSubmatch(text, -1) {
+			identifier := match[1]
+			if strings.HasPrefix(identifier, "#") {
+				identifier = repoName + identifier
+			}
+			key := strings.ToLower(identifier)
+			if found[key] {
+				continue
+			}
+			found[key] = true
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	return identifiers
+}
+
+func issueReferencesQuery(count int) string {
+	var args, repos strings.Builder
+	for i := 0; i < count; i++ {
+		args.WriteString(fmt.Sprintf(", $o%d: String!, $n%d: String!, $i%d: Int!", i, i, i))
+		repos.WriteString(fmt.Sprintf(`
+	r%d: repository(owner: $o%d, name: $n%d) {
+		issueOrPullRequest(number: $i%d) {
+			...on Issue {
+				%s
+			}
+		}
+	}`, i, i, i, i, issueReferenceFields))
+	}
+	return fmt.Sprintf(`query GetIssueReferences(%s) {%s
+}`, strings.TrimPrefix(args.String(), ", "), repos.String())
+}
+
+// resolveIssueReferences returns the issues for the owner/repo#number identifiers, skipping any which are pull requests.
+// a reference to a repo which doesn't exist fails the whole query so the references are skipped rather than failing the
+// pull request
+func (g *GithubIntegration) resolveIssueReferences(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, identifiers []string) ([]issueReference, error) {
+	if len(identifiers) > maxIssueReferences {
+		identifiers = identifiers[:maxIssueReferences]
+	}
+	variables := make(map[string]interface{})
+	var count int
+	for _, identifier := range identifiers {
+		i := strings.LastIndex(identifier, "#")
+		number, err := strconv.Atoi(identifier[i+1:])
+		if err != nil {
+			continue
+		}
+		tok := strings.Split(identifier[:i], "/")
+		if len(tok) != 2 {
+			continue
+		}
+		variables[fmt.Sprintf("o%d", count)] = tok[0]
+		variables[fmt.Sprintf("n%d", count)] = tok[1]
+		variables[fmt.Sprintf("i%d", count)] = number
+		count++
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	var result map[string]json.RawMessage
+	if err := g.newQueryExecutor(logger, client, control).Query(issueReferencesQuery(count), variables, &result); err != nil {
+		if isFatalExportError(err) {
+			return nil, err
+		}
+		sdk.LogWarn(logger, "error resolving issue references, skipping", "references", identifiers, "err", err)
+		return nil, nil
+	}
+	refs := make([]issueReference, 0)
+	for i := 0; i < count; i++ {
+		var repo struct {
+			Issue *issueReference `json:"issueOrPullRequest"`
+		}
+		buf, ok := result[fmt.Sprintf("r%d", i)]
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(buf, &repo); err != nil {
+			return nil, fmt.Errorf("error decoding issue reference: %w", err)
+		}
+		if repo.Issue != nil && repo.Issue.ID != "" {
+			refs = append(refs, *repo.Issue)
+		}
+	}
+	return refs, nil
+}
+
+// setPullRequestIssues sets the issues which the pull request closes from github's closing references and the closing
+// keywords in the title, body and commit messages. this only links the pull request to the issues, the issues are
+// linked back from github's closedByPullRequestsReferences when they are exported or their issues webhook is received
+func (g *GithubIntegration) setPullRequestIssues(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, pullrequest *sdk.SourceCodePullRequest, repoName string, closing issueReferences, texts ...string) error {
+	found := make(map[string]bool)
+	pullrequest.IssueIds = make([]string, 0)
+	for _, ref := range closing.Nodes {
+		found[strings.ToLower(ref.Identifier())] = true
+		pullrequest.IssueIds = append(pullrequest.IssueIds, sdk.NewWorkIssueID(pullrequest.CustomerID, ref.ID, refType))
+	}
+	missing := make([]string, 0)
+	for _, identifier := range parseClosingReferences(repoName, texts...) {
+		if !found[strings.ToLower(identifier)] {
+			missing = append(missing, identifier)
+		}
+	}
+	refs, err := g.resolveIssueReferences(logger, client, control, missing)
+	if err != nil {
+		return err
+	}
+	for _, ref := range refs {
+		pullrequest.IssueIds = append(pullrequest.IssueIds, sdk.NewWorkIssueID(pullrequest.CustomerID, ref.ID, refType))
+	}
+	return nil
+}
+
+// pullRequestTexts returns the texts of the pull request which can have closing keywords
+func pullRequestTexts(title string, body string, commits []*sdk.SourceCodePullRequestCommit) []string {
+	texts := []string{title, body}
+	for _, commit := range commits {
+		texts = append(texts, commit.Message)
+	}
+	return texts
+}
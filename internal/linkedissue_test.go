@@ -0,0 +1,22 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseClosingReferences(t *testing.T) {
+	assert := assert.New(t)
+	refs := parseClosingReferences("pinpt/agent", "Fixes #12 and closes pinpt/other#3", "resolved: #12, see #99", "fixed pinpt/Other#3")
+	assert.Equal([]string{"pinpt/agent#12", "pinpt/other#3"}, refs)
+	assert.Empty(parseClosingReferences("pinpt/agent", "prefix#12", "fixes 12"))
+}
+
+func TestIssueReferencesQuery(t *testing.T) {
+	assert := assert.New(t)
+	query := issueReferencesQuery(2)
+	assert.Contains(query, "query GetIssueReferences($o0: String!, $n0: String!, $i0: Int!, $o1: String!, $n1: String!, $i1: Int!)")
+	assert.Equal(2, strings.Count(query, "issueOrPullRequest("))
+}
@@ -9,11 +9,19 @@ func (g *GithubIntegration) Mutation(mutation sdk.Mutation) (*sdk.MutationRespon
 	logger := mutation.Logger()
 	sdk.LogInfo(logger, "mutation request received", "action", mutation.Action(), "id", mutation.ID(), "model", mutation.Model())
 	userManager := NewUserManager(mutation.CustomerID(), []string{""}, mutation, mutation.State(), mutation.Pipe(), g, mutation.IntegrationInstanceID(), false)
+	issueTypes, err := g.getIssueTypeMapping(mutation.Config())
+	if err != nil {
+		return nil, err
+	}
+	priorities, err := g.getIssuePriorityMapping(mutation.Config())
+	if err != nil {
+		return nil, err
+	}
 	switch mutation.Action() {
 	case sdk.CreateAction:
 		switch v := mutation.Payload().(type) {
 		case *sdk.WorkIssueCreateMutation:
-			return g.createIssue(logger, userManager, v, mutation.User())
+			return g.createIssue(logger, userManager, issueTypes, priorities, v, mutation.User())
 		}
 		break
 	case sdk.UpdateAction:
@@ -21,7 +29,7 @@ func (g *GithubIntegration) Mutation(mutation sdk.Mutation) (*sdk.MutationRespon
 		case *sdk.SourcecodePullRequestUpdateMutation:
 			return nil, g.updatePullrequest(logger, mutation.Config(), mutation.ID(), v, mutation.User())
 		case *sdk.WorkIssueUpdateMutation:
-			return g.UpdateIssue(logger, userManager, mutation.ID(), v, mutation.User())
+			return g.UpdateIssue(logger, mutation, userManager, issueTypes, priorities, mutation.ID(), v, mutation.User())
 		}
 	case sdk.DeleteAction:
 		break
@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+const issuePriorityCacheKeyPrefix = "issue_priority_"
+
+// issuePriorityRule maps the labels which match it to a priority. a rule matches a label by its name, ignoring case, or
+// by a regular expression. the priority of a rule is its name so the same priority can be a different label in each repo
+type issuePriorityRule struct {
+	Label   string `json:"label"`
+	Pattern string `json:"pattern"`
+	Name    string `json:"name"`
+
+	re *regexp.Regexp
+}
+
+// issuePriorityMapping is ordered from the highest to the lowest priority
+type issuePriorityMapping []*issuePriorityRule
+
+// hash returns a hash of the rules which is part of the cache keys so the priorities are written again when the
+// mapping changes
+func (m issuePriorityMapping) hash() string {
+	buf, _ := json.Marshal(m)
+	return sdk.Hash(string(buf))
+}
+
+// defaultIssuePriorityMapping is used when the instance doesn't configure a mapping
+var defaultIssuePriorityMapping = issuePriorityMapping{
+	{Label: "P0", Name: "P0"},
+	{Label: "P1", Name: "P1"},
+	{Label: "P2", Name: "P2"},
+	{Label: "P3", Name: "P3"},
+}
+
+func (r *issuePriorityRule) matches(labelName string) bool {
+	if r.re != nil {
+		return r.re.MatchString(labelName)
+	}
+	return strings.EqualFold(r.Label, labelName)
+}
+
+// match returns the first rule which matches the label or nil if the label isn't a priority
+func (m issuePriorityMapping) match(labelName string) *issuePriorityRule {
+	for _, rule := range m {
+		if rule.matches(labelName) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// find returns the rule for the priority name or nil if there isn't one
+func (m issuePriorityMapping) find(name string) *issuePriorityRule {
+	for _, rule := range m {
+		if rule.Name == name {
+			return rule
+		}
+	}
+	return nil
+}
+
+// parseIssuePriorityMapping parses the mapping from the config which can either be a list of rules or a JSON string of them
+func parseIssuePriorityMapping(val interface{}) (issuePriorityMapping, error) {
+	var buf []byte
+	if str, ok := val.(string); ok {
+		buf = []byte(str)
+	} else {
+		b, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		buf = b
+	}
+	var mapping issuePriorityMapping
+	if err := json.Unmarshal(buf, &mapping); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for i, rule := range mapping {
+		if rule == nil || (rule.Label == "" && rule.Pattern == "") {
+			return nil, fmt.Errorf("issue priority rule %d must have a label or a pattern", i)
+		}
+		if rule.Name == "" {
+			if rule.Label == "" {
+				return nil, fmt.Errorf("issue priority rule %d with a pattern must have a name", i)
+			}
+			rule.Name = rule.Label
+		}
+		if names[rule.Name] {
+			return nil, fmt.Errorf("issue priority rule %d has a duplicate name: %s", i, rule.Name)
+		}
+		names[rule.Name] = true
+		if rule.Pattern != "" {
+			re, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("issue priority rule %d has an invalid pattern: %w", i, err)
+			}
+			rule.re = re
+		}
+	}
+	return mapping, nil
+}
+
+// getIssuePriorityMapping returns the label to priority mapping configured for the instance or the default mapping
+func (g *GithubIntegration) getIssuePriorityMapping(config sdk.Config) (issuePriorityMapping, error) {
+	ok, val := config.Get("issue_priorities")
+	if !ok {
+		ok, val = g.config.Get("issue_priorities")
+	}
+	if !ok || val == nil {
+		return defaultIssuePriorityMapping, nil
+	}
+	mapping, err := parseIssuePriorityMapping(val)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing issue_priorities config: %w", err)
+	}
+	return mapping, nil
+}
+
+// processIssuePriorities will write out the priorities of the mapping
+func (g *GithubIntegration) processIssuePriorities(logger sdk.Logger, pipe sdk.Pipe, state sdk.State, customerID string, integrationInstanceID string, historical bool, priorities issuePriorityMapping) error {
+	for i, rule := range priorities {
+		key := issuePriorityCacheKeyPrefix + priorities.hash() + "_" + rule.Name
+		if !historical && state.Exists(key) {
+			continue
+		}
+		var p sdk.WorkIssuePriority
+		p.CustomerID = customerID
+		p.IntegrationInstanceID = sdk.StringPointer(integrationInstanceID)
+		p.RefID = rule.Name
+		p.RefType = refType
+		p.Name = rule.Name
+		p.Order = int64(i)
+		p.ID = sdk.NewWorkIssuePriorityID(customerID, refType, p.RefID)
+		if err := pipe.Write(&p); err != nil {
+			return err
+		}
+		sdk.LogDebug(logger, "writing issue priority to state", "name", p.Name)
+		if err := state.Set(key, p.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setIssuePriority sets the priority on the issue from the first label which is a priority
+func setIssuePriority(issue *sdk.WorkIssue, priorities issuePriorityMapping, labels []label) {
+	for _, label := range labels {
+		if rule := priorities.match(label.Name); rule != nil {
+			issue.Priority = rule.Name
+			issue.PriorityID = sdk.NewWorkIssuePriorityID(issue.CustomerID, refType, rule.Name)
+			return
+		}
+	}
+}
+
+// issuePriorityLabels returns the labels for the issue once its priority label has been swapped for the label of the
+// new priority in the repo of the issue
+func (g *GithubIntegration) issuePriorityLabels(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, priorities issuePriorityMapping, issueRefID string, priority *sdk.NameRefID) ([]string, error) {
+	if priority.RefID == nil {
+		return nil, errors.New("ref_id was omitted")
+	}
+	rule := priorities.find(*priority.RefID)
+	if rule == nil {
+		return nil, fmt.Errorf("%s isn't a configured priority", *priority.RefID)
+	}
+	var result struct {
+		Node struct {
+			Labels     labelNode `json:"labels"`
+			Repository struct {
+				Name string `json:"nameWithOwner"`
+			} `json:"repository"`
+		} `json:"node"`
+	}
+	if err := g.newQueryExecutor(logger, client, control).Query(issueLabelsQuery, map[string]interface{}{"id": issueRefID}, &result); err != nil {
+		return nil, fmt.Errorf("error fetching issue labels: %w", err)
+	}
+	repoLabels, err := g.fetchRepoLabels(logger, client, control, result.Node.Repository.Name, "")
+	if err != nil {
+		return nil, err
+	}
+	var priorityLabelID string
+	for _, l := range repoLabels {
+		if rule.matches(l.Name) {
+			priorityLabelID = l.ID
+			break
+		}
+	}
+	if priorityLabelID == "" {
+		return nil, fmt.Errorf("%s doesn't have a label for priority %s", result.Node.Repository.Name, rule.Name)
+	}
+	labelIDs := []string{priorityLabelID}
+	for _, l := range result.Node.Labels.Nodes {
+		if priorities.match(l.Name) == nil {
+			labelIDs = append(labelIDs, l.ID)
+		}
+	}
+	return labelIDs, nil
+}
@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIssuePriorityMapping(t *testing.T) {
+	assert := assert.New(t)
+	mapping, err := parseIssuePriorityMapping(`[{"pattern":"(?i)^priority: ?high$","name":"High"},{"label":"low"}]`)
+	assert.NoError(err)
+	assert.Equal("High", mapping.match("Priority:high").Name)
+	assert.Equal("low", mapping.match("LOW").Name)
+	assert.Nil(mapping.match("priority: medium"))
+	assert.Equal("High", mapping.find("High").Name)
+	assert.Nil(mapping.find("Medium"))
+
+	_, err = parseIssuePriorityMapping(`[{"pattern":"^p0$"}]`)
+	assert.Error(err)
+	_, err = parseIssuePriorityMapping(`[{"label":"P0"},{"label":"p0-urgent","name":"P0"}]`)
+	assert.Error(err)
+}
+
+func TestSetIssuePriority(t *testing.T) {
+	assert := assert.New(t)
+	var issue sdk.WorkIssue
+	setIssuePriority(&issue, defaultIssuePriorityMapping, []label{{ID: "1", Name: "bug"}, {ID: "2", Name: "p1"}, {ID: "3", Name: "P0"}})
+	assert.Equal("P1", issue.Priority)
+	issue = sdk.WorkIssue{}
+	setIssuePriority(&issue, defaultIssuePriorityMapping, []label{{ID: "1", Name: "bug"}})
+	assert.Empty(issue.Priority)
+}
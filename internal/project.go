@@ -127,7 +127,7 @@ func (p repoProject) ToModel(logger sdk.Logger, customerID string, integrationIn
 	return &board, &kanban
 }
 
-func (r repository) ToProjectModel(repo *sdk.SourceCodeRepo) *sdk.WorkProject {
+func (r repository) ToProjectModel(repo *sdk.SourceCodeRepo, issueTypes issueTypeMapping) *sdk.WorkProject {
 	if !r.HasIssues {
 		return nil
 	}
@@ -146,7 +146,7 @@ func (r repository) ToProjectModel(repo *sdk.SourceCodeRepo) *sdk.WorkProject {
 	project.CustomerID = repo.CustomerID
 	project.UpdatedAt = repo.UpdatedAt
 
-	issueTypes := []sdk.WorkProjectIssueTypes{
+	projectIssueTypes := []sdk.WorkProjectIssueTypes{
 		{
 			Name:  "Epic",
 			RefID: "epic",
@@ -158,26 +158,28 @@ func (r repository) ToProjectModel(repo *sdk.SourceCodeRepo) *sdk.WorkProject {
 	}
 
 	for _, l := range r.Labels.Nodes {
-		if l.Name == "bug" || l.Name == "enhancement" {
-			issueTypes = append(issueTypes, sdk.WorkProjectIssueTypes{
-				Name:  strings.Title(l.Name),
+		if rule := issueTypes.match(l.Name); rule != nil {
+			projectIssueTypes = append(projectIssueTypes, sdk.WorkProjectIssueTypes{
+				Name:  rule.typeName(l.Name),
 				RefID: l.ID,
 			})
 		}
 	}
 
-	project.IssueTypes = issueTypes
+	project.IssueTypes = projectIssueTypes
 
 	return &project
 }
 
-const projectCapabilityCacheKeyPrefix = "project_capability_"
+// projectCapabilityCacheKeyPrefix is versioned so the capabilities are sent again when they change. the key also has
+// a hash of the issue type and priority mappings since they change the mutation fields
+const projectCapabilityCacheKeyPrefix = "project_capability_v2_"
 
-func (r repository) ToProjectCapabilityModel(state sdk.State, repo *sdk.SourceCodeRepo, historical bool) *sdk.WorkProjectCapability {
+func (r repository) ToProjectCapabilityModel(state sdk.State, repo *sdk.SourceCodeRepo, historical bool, issueTypes issueTypeMapping, priorities issuePriorityMapping) *sdk.WorkProjectCapability {
 	if !r.HasIssues {
 		return nil
 	}
-	var cacheKey = projectCapabilityCacheKeyPrefix + repo.ID
+	var cacheKey = projectCapabilityCacheKeyPrefix + sdk.Hash(issueTypes.hash(), priorities.hash()) + "_" + repo.ID
 	if !historical && state.Exists(cacheKey) {
 		return nil
 	}
@@ -190,34 +192,38 @@ func (r repository) ToProjectCapabilityModel(state sdk.State, repo *sdk.SourceCo
 	capability.ProjectID = sdk.NewWorkProjectID(repo.CustomerID, repo.RefID, refType)
 	capability.UpdatedAt = repo.UpdatedAt
 	capability.Attachments = false
-	capability.ChangeLogs = false
+	capability.ChangeLogs = true
 	capability.DueDates = false
 	capability.Epics = true
 	capability.InProgressStates = false
 	capability.KanbanBoards = r.HasProjects
 	capability.LinkedIssues = true
 	capability.Parents = true
-	capability.Priorities = false
-	capability.Resolutions = false
+	capability.Priorities = true
+	capability.Resolutions = true
 	capability.Sprints = false
 	capability.StoryPoints = false
-	capability.IssueMutationFields = createMutationFields(r.Labels.Nodes)
+	capability.IssueMutationFields = createMutationFields(r.Labels.Nodes, issueTypes, priorities)
 	state.SetWithExpires(cacheKey, 1, time.Hour*24*30)
 	return &capability
 }
 
-func createMutationFields(labels []label) []sdk.WorkProjectCapabilityIssueMutationFields {
+func createMutationFields(labels []label, issueTypes issueTypeMapping, priorities issuePriorityMapping) []sdk.WorkProjectCapabilityIssueMutationFields {
 
 	labelRefID := make([]string, 0)
+	var hasPriorities bool
 	for _, l := range labels {
-		if l.Name == "bug" || l.Name == "enhancement" {
+		if issueTypes.match(l.Name) != nil {
 			labelRefID = append(labelRefID, l.ID)
 		}
+		if priorities.match(l.Name) != nil {
+			hasPriorities = true
+		}
 	}
 
 	commonIssueTypes := []string{"epic", ""}
 
-	return []sdk.WorkProjectCapabilityIssueMutationFields{
+	fields := []sdk.WorkProjectCapabilityIssueMutationFields{
 		{
 			AlwaysAvailable:   true,
 			Name:              "Title",
@@ -256,9 +262,28 @@ func createMutationFields(labels []label) []sdk.WorkProjectCapabilityIssueMutati
 			AlwaysRequired:    false,
 			RefID:             "epicDueDate",
 			Immutable:         false,
-			AvailableForTypes: append([]string{"epic"}),
+			AvailableForTypes: []string{"epic"},
 			Type:              sdk.WorkProjectCapabilityIssueMutationFieldsTypeDate,
 		},
 	}
 
+	// the priority is changed by swapping labels so the repo needs a label for at least one of the priorities
+	if hasPriorities {
+		names := make([]string, 0, len(priorities))
+		for _, rule := range priorities {
+			names = append(names, rule.Name)
+		}
+		fields = append(fields, sdk.WorkProjectCapabilityIssueMutationFields{
+			AlwaysAvailable:   false,
+			Name:              "Priority",
+			Description:       sdk.StringPointer("priority of the issue, one of " + strings.Join(names, ", ")),
+			AlwaysRequired:    false,
+			RefID:             "priority",
+			Immutable:         false,
+			AvailableForTypes: append(commonIssueTypes, labelRefID...),
+			Type:              sdk.WorkProjectCapabilityIssueMutationFieldsTypeWorkIssuePriority,
+		})
+	}
+
+	return fields
 }
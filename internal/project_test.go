@@ -3,6 +3,7 @@ package internal
 import (
 	"testing"
 
+	"github.com/pinpt/agent/v4/sdk"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,3 +29,30 @@ func TestGetProjectIDfromURLErr(t *testing.T) {
 	_, err = getProjectIDfromURL(url)
 	assert.EqualError(err, "strconv.ParseInt: parsing \"abc\": invalid syntax")
 }
+
+func TestCreateMutationFieldsPriority(t *testing.T) {
+	assert := assert.New(t)
+	fields := createMutationFields([]label{{ID: "L_1", Name: "bug"}, {ID: "L_2", Name: "p1"}}, defaultIssueTypeMapping, defaultIssuePriorityMapping)
+	priority := fields[len(fields)-1]
+	assert.Equal("priority", priority.RefID)
+	assert.Equal(sdk.WorkProjectCapabilityIssueMutationFieldsTypeWorkIssuePriority, priority.Type)
+	assert.Equal("priority of the issue, one of P0, P1, P2, P3", *priority.Description)
+	assert.Contains(priority.AvailableForTypes, "L_1")
+
+	fields = createMutationFields([]label{{ID: "L_1", Name: "bug"}}, defaultIssueTypeMapping, defaultIssuePriorityMapping)
+	for _, field := range fields {
+		assert.NotEqual("priority", field.RefID)
+	}
+}
+
+func TestToProjectCapabilityModelMappingChange(t *testing.T) {
+	assert := assert.New(t)
+	state := &mockState{}
+	r := repository{HasIssues: true}
+	repo := &sdk.SourceCodeRepo{ID: "R_1", RefID: "1", CustomerID: "1234"}
+	assert.NotNil(r.ToProjectCapabilityModel(state, repo, false, defaultIssueTypeMapping, defaultIssuePriorityMapping))
+	assert.Nil(r.ToProjectCapabilityModel(state, repo, false, defaultIssueTypeMapping, defaultIssuePriorityMapping))
+	priorities, err := parseIssuePriorityMapping(`[{"label":"urgent","name":"Urgent"}]`)
+	assert.NoError(err)
+	assert.NotNil(r.ToProjectCapabilityModel(state, repo, false, defaultIssueTypeMapping, priorities))
+}
@@ -9,10 +9,6 @@ import (
 	"github.com/pinpt/agent/v4/sdk"
 )
 
-type pullrequestTimelineItems struct {
-	Nodes []author
-}
-
 type pullrequest struct {
 	ID             string                    `json:"id"`
 	Body           string                    `json:"bodyHTML"`
@@ -26,6 +22,7 @@ type pullrequest struct {
 	Title          string                    `json:"title"`
 	CreatedAt      time.Time                 `json:"createdAt"`
 	UpdatedAt      time.Time                 `json:"updatedAt"`
+	ClosedAt       *time.Time                `json:"closedAt"`
 	MergedAt       time.Time                 `json:"mergedAt"`
 	Author         author                    `json:"author"`
 	Branch         string                    `json:"branch"`
@@ -36,6 +33,11 @@ type pullrequest struct {
 	ReviewRequests pullrequestreviewrequests `json:"reviewRequests"`
 	Comments       pullrequestcomments       `json:"comments"`
 	TimelineItems  pullrequestTimelineItems  `json:"timelineItems"`
+	HeadCommit     pullrequestHeadCommit     `json:"headCommit"`
+	ReviewThreads  pullrequestreviewthreads  `json:"reviewThreads"`
+	Files          pullrequestfiles          `json:"files"`
+	RawBody        string                    `json:"rawBody"`
+	ClosingIssues  issueReferences           `json:"closingIssuesReferences"`
 	Labels         struct {
 		Nodes []struct {
 			Name string `json:"name"`
@@ -49,7 +51,7 @@ func (g *GithubIntegration) fromPullRequestEvent(logger sdk.Logger, client sdk.G
 		action = *pr.Action
 	}
 	switch action {
-	case "opened", "synchronize", "edited", "ready_for_review", "locked", "unlocked", "reopened", "closed", "converted_to_draft":
+	case "opened", "synchronize", "edited", "ready_for_review", "locked", "unlocked", "reopened", "closed", "converted_to_draft", "review_requested":
 		var object pullrequest
 		object.ID = *pr.PullRequest.NodeID
 		if pr.PullRequest.Body != nil {
@@ -60,9 +62,6 @@ func (g *GithubIntegration) fromPullRequestEvent(logger sdk.Logger, client sdk.G
 			// If the action is "closed" and the "merged" key is "false", the pull request was closed with unmerged commits.
 			// If the action is "closed" and the "merged" key is "true", the pull request was merged.
 			object.Closed = true
-			object.TimelineItems = pullrequestTimelineItems{
-				Nodes: []author{userToAuthor(pr.Sender)},
-			}
 		}
 		object.Author = userToAuthor(pr.PullRequest.User)
 		object.Draft = *pr.PullRequest.Draft
@@ -82,6 +81,7 @@ func (g *GithubIntegration) fromPullRequestEvent(logger sdk.Logger, client sdk.G
 		if pr.PullRequest.MergedAt != nil {
 			object.MergedAt = *pr.PullRequest.MergedAt
 		}
+		object.ClosedAt = pr.PullRequest.ClosedAt
 		object.Branch = *pr.PullRequest.Head.Ref
 		object.MergeCommit = oidProp{*pr.PullRequest.Base.SHA}
 		repoID := sdk.NewSourceCodeRepoID(customerID, *pr.Repo.NodeID, refType)
@@ -101,6 +101,40 @@ func (g *GithubIntegration) fromPullRequestEvent(logger sdk.Logger, client sdk.G
 		}
 
 		setPullRequestCommits(result, commits)
+		var closing struct {
+			Node struct {
+				ClosingIssues issueReferences `json:"closingIssuesReferences"`
+			} `json:"node"`
+		}
+		if err := g.newQueryExecutor(logger, client, control).Query(pullrequestClosingIssuesQuery, map[string]interface{}{"id": object.ID}, &closing); err != nil {
+			return nil, fmt.Errorf("error fetching pull request closing issues: %w", err)
+		}
+		// the referenced issues aren't written here, their links come from the issue export and the issues webhook
+		if err := g.setPullRequestIssues(logger, client, control, result, *pr.Repo.FullName, closing.Node.ClosingIssues, pullRequestTexts(object.Title, pr.PullRequest.GetBody(), commits)...); err != nil {
+			return nil, err
+		}
+		// the webhook doesn't have the files so fetch them all, writing the pull request without them if we can't
+		if err := g.setPullRequestFiles(logger, client, control, result, *pr.Repo.FullName, object.Number, pullrequestfiles{PageInfo: pageInfo{HasNextPage: true}}); err != nil {
+			if isFatalExportError(err) {
+				return nil, err
+			}
+			sdk.LogWarn(logger, "error fetching pull request files, writing the pull request without them", "id", object.ID, "err", err)
+		}
+		// the webhook doesn't have the timeline so fetch it all, writing the pull request without events if we can't
+		if err := g.setPullRequestEvents(logger, client, userManager, control, result, pullrequestTimelineItems{PageInfo: pageInfo{HasNextPage: true}}); err != nil {
+			if isFatalExportError(err) {
+				return nil, err
+			}
+			sdk.LogWarn(logger, "error fetching pull request timeline, writing the pull request without events", "id", object.ID, "err", err)
+		}
+		if result.Status == sdk.SourceCodePullRequestStatusClosed && result.ClosedByRefID == "" {
+			// the close event may not be on the timeline yet so use the sender of the webhook
+			sender := userToAuthor(pr.Sender)
+			if err := userManager.emitAuthor(logger, sender); err != nil {
+				return nil, err
+			}
+			result.ClosedByRefID = sender.RefID(customerID)
+		}
 		return result, nil
 	default:
 		sdk.LogInfo(logger, "unhandled pull request action: "+action)
@@ -168,13 +202,12 @@ func (pr pullrequest) ToModel(logger sdk.Logger, userManager *UserManager, custo
 		}
 	case "CLOSED":
 		pullrequest.Status = sdk.SourceCodePullRequestStatusClosed
-		if len(pr.TimelineItems.Nodes) > 0 {
-			if err := userManager.emitAuthor(logger, pr.TimelineItems.Nodes[0]); err != nil {
-				return nil, err
-			}
-			pullrequest.ClosedByRefID = pr.TimelineItems.Nodes[0].RefID(customerID)
+		// who closed it comes from the close event on the timeline, see setPullRequestEvents
+		if pr.ClosedAt != nil {
+			sdk.ConvertTimeToDateModel(*pr.ClosedAt, &pullrequest.ClosedDate)
+		} else {
+			sdk.ConvertTimeToDateModel(pr.UpdatedAt, &pullrequest.ClosedDate)
 		}
-		sdk.ConvertTimeToDateModel(pr.UpdatedAt, &pullrequest.ClosedDate)
 	case "MERGED":
 		pullrequest.Status = sdk.SourceCodePullRequestStatusMerged
 	}
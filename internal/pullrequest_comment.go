@@ -46,7 +46,7 @@ func pullRequestURLToNumber(url string) int64 {
 	return num
 }
 
-func (g *GithubIntegration) fetchPullRequestNodeIDFromIssueID(client sdk.GraphQLClient, repoLogin, repoName string, id int64) (string, error) {
+func (g *GithubIntegration) fetchPullRequestNodeIDFromIssueID(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, repoLogin, repoName string, id int64) (string, error) {
 	variables := map[string]interface{}{
 		"name":   repoName,
 		"owner":  repoLogin,
@@ -59,7 +59,7 @@ func (g *GithubIntegration) fetchPullRequestNodeIDFromIssueID(client sdk.GraphQL
 			} `json:"pullRequest"`
 		} `json:"repository"`
 	}
-	if err := client.Query(pullrequestNodeIDQuery, variables, &res); err != nil {
+	if err := g.newQueryExecutor(logger, client, control).Query(pullrequestNodeIDQuery, variables, &res); err != nil {
 		return "", err
 	}
 	return res.Repository.PullRequest.ID, nil
@@ -80,7 +80,7 @@ func (g *GithubIntegration) fromPullRequestCommentEvent(logger sdk.Logger, clien
 	repoID := sdk.NewSourceCodeRepoID(customerID, commentEvent.Repo.GetNodeID(), refType)
 	// unfortunately, we have to make a graphql query to convert the PR number to the PR nodeid
 	prNum := pullRequestURLToNumber(commentEvent.Issue.PullRequestLinks.GetURL())
-	prNodeID, err := g.fetchPullRequestNodeIDFromIssueID(client, commentEvent.GetRepo().GetOwner().GetLogin(), commentEvent.GetRepo().GetName(), prNum)
+	prNodeID, err := g.fetchPullRequestNodeIDFromIssueID(logger, client, control, commentEvent.GetRepo().GetOwner().GetLogin(), commentEvent.GetRepo().GetName(), prNum)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching pull request node id: %w", err)
 	}
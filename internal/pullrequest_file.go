@@ -0,0 +1,156 @@
+package internal
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+type pullrequestfile struct {
+	Path       string `json:"path"`
+	Additions  int64  `json:"additions"`
+	Deletions  int64  `json:"deletions"`
+	ChangeType string `json:"changeType"`
+}
+
+type pullrequestfiles struct {
+	TotalCount int               `json:"totalCount"`
+	PageInfo   pageInfo          `json:"pageInfo"`
+	Nodes      []pullrequestfile `json:"nodes"`
+}
+
+// languages maps a file extension to the language of the file
+var languages = map[string]string{
+	".c":      "C",
+	".h":      "C",
+	".cc":     "C++",
+	".cpp":    "C++",
+	".hpp":    "C++",
+	".cs":     "C#",
+	".clj":    "Clojure",
+	".css":    "CSS",
+	".scss":   "SCSS",
+	".dart":   "Dart",
+	".ex":     "Elixir",
+	".exs":    "Elixir",
+	".erl":    "Erlang",
+	".go":     "Go",
+	".groovy": "Groovy",
+	".hs":     "Haskell",
+	".html":   "HTML",
+	".java":   "Java",
+	".js":     "JavaScript",
+	".jsx":    "JavaScript",
+	".mjs":    "JavaScript",
+	".json":   "JSON",
+	".kt":     "Kotlin",
+	".kts":    "Kotlin",
+	".lua":    "Lua",
+	".m":      "Objective-C",
+	".md":     "Markdown",
+	".php":    "PHP",
+	".pl":     "Perl",
+	".proto":  "Protocol Buffer",
+	".py":     "Python",
+	".r":      "R",
+	".rb":     "Ruby",
+	".rs":     "Rust",
+	".scala":  "Scala",
+	".sh":     "Shell",
+	".sql":    "SQL",
+	".swift":  "Swift",
+	".tf":     "HCL",
+	".ts":     "TypeScript",
+	".tsx":    "TypeScript",
+	".vue":    "Vue",
+	".xml":    "XML",
+	".yaml":   "YAML",
+	".yml":    "YAML",
+}
+
+// fileLanguage returns the language of the file from its extension or an empty string if we don't know it
+func fileLanguage(path string) string {
+	if filepath.Base(path) == "Dockerfile" {
+		return "Dockerfile"
+	}
+	return languages[strings.ToLower(filepath.Ext(path))]
+}
+
+func (f pullrequestfile) ToModel() sdk.SourceCodePullRequestFiles {
+	file := sdk.SourceCodePullRequestFiles{
+		Path:      f.Path,
+		Language:  fileLanguage(f.Path),
+		Additions: f.Additions,
+		Deletions: f.Deletions,
+	}
+	switch f.ChangeType {
+	case "ADDED", "COPIED":
+		file.Status = sdk.SourceCodePullRequestFilesStatusAdded
+	case "RENAMED":
+		file.Status = sdk.SourceCodePullRequestFilesStatusRenamed
+	case "DELETED":
+		file.Status = sdk.SourceCodePullRequestFilesStatusDeleted
+	default:
+		file.Status = sdk.SourceCodePullRequestFilesStatusModified
+	}
+	return file
+}
+
+// fetchPullRequestFiles returns the files changed in the pull request after the cursor
+func (g *GithubIntegration) fetchPullRequestFiles(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, repoName string, prNumber int, cursor string) ([]pullrequestfile, error) {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	variables := map[string]interface{}{
+		"first":  100,
+		"owner":  repoOwner,
+		"name":   repoLogin,
+		"number": prNumber,
+	}
+	if cursor != "" {
+		variables["after"] = cursor
+	}
+	exec := g.newQueryExecutor(logger, client, control)
+	files := make([]pullrequestfile, 0)
+	for {
+		sdk.LogDebug(logger, "running fetch pull request files", "number", prNumber, "repo", repoName, "after", variables["after"])
+		var result struct {
+			RateLimit  rateLimit `json:"rateLimit"`
+			Repository struct {
+				PullRequest struct {
+					Files pullrequestfiles `json:"files"`
+				} `json:"pullRequest"`
+			} `json:"repository"`
+		}
+		if err := exec.Query(pullrequestFilesPagedQuery, variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching pull request files: %w", err)
+		}
+		files = append(files, result.Repository.PullRequest.Files.Nodes...)
+		if !result.Repository.PullRequest.Files.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return nil, err
+		}
+		variables["after"] = result.Repository.PullRequest.Files.PageInfo.EndCursor
+	}
+	return files, nil
+}
+
+// setPullRequestFiles sets the files on the pull request, fetching any which weren't on the first page
+func (g *GithubIntegration) setPullRequestFiles(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, pullrequest *sdk.SourceCodePullRequest, repoName string, prNumber int, files pullrequestfiles) error {
+	// leave the files empty if we can't fetch all of them
+	pullrequest.Files = make([]sdk.SourceCodePullRequestFiles, 0)
+	nodes := files.Nodes
+	if files.PageInfo.HasNextPage {
+		more, err := g.fetchPullRequestFiles(logger, client, control, repoName, prNumber, files.PageInfo.EndCursor)
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, more...)
+	}
+	for _, node := range nodes {
+		pullrequest.Files = append(pullrequest.Files, node.ToModel())
+	}
+	return nil
+}
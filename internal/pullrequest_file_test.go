@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequestFileToModel(t *testing.T) {
+	assert := assert.New(t)
+	file := pullrequestfile{Path: "internal/export.go", Additions: 10, Deletions: 2, ChangeType: "RENAMED"}.ToModel()
+	assert.Equal("Go", file.Language)
+	assert.Equal(sdk.SourceCodePullRequestFilesStatusRenamed, file.Status)
+	assert.EqualValues(10, file.Additions)
+	assert.Equal(sdk.SourceCodePullRequestFilesStatusModified, pullrequestfile{ChangeType: "CHANGED"}.ToModel().Status)
+	assert.Equal("Dockerfile", fileLanguage("build/Dockerfile"))
+	assert.Equal("", fileLanguage("LICENSE"))
+}
+
+func TestSetPullRequestFilesError(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	pullrequest := &sdk.SourceCodePullRequest{}
+	files := pullrequestfiles{Nodes: []pullrequestfile{{Path: "main.go"}}}
+	files.PageInfo.HasNextPage = true
+	files.PageInfo.EndCursor = "f1"
+	client := &mockPagedGraphQLClient{}
+	assert.Error(g.setPullRequestFiles(sdk.NewNoOpTestLogger(), client, &mockControl{}, pullrequest, "pinpt/agent", 1, files))
+	assert.NotNil(pullrequest.Files)
+	assert.Empty(pullrequest.Files)
+}
@@ -0,0 +1,263 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+type pullrequestreviewcomment struct {
+	ID           string    `json:"id"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+	Author       author    `json:"author"`
+	URL          string    `json:"url"`
+	Body         string    `json:"bodyHTML"`
+	Path         string    `json:"path"`
+	Line         *int64    `json:"line"`
+	OriginalLine *int64    `json:"originalLine"`
+	DiffHunk     string    `json:"diffHunk"`
+	Outdated     bool      `json:"outdated"`
+	ReplyTo      *idProp   `json:"replyTo"`
+	Review       *idProp   `json:"pullRequestReview"`
+	// Thread is only fetched for a single comment, the thread queries have it already
+	Thread *struct {
+		IsResolved bool `json:"isResolved"`
+	} `json:"pullRequestReviewThread"`
+}
+
+type pullrequestreviewcomments struct {
+	PageInfo pageInfo                   `json:"pageInfo"`
+	Nodes    []pullrequestreviewcomment `json:"nodes"`
+}
+
+type pullrequestreviewthread struct {
+	ID         string                    `json:"id"`
+	IsResolved bool                      `json:"isResolved"`
+	IsOutdated bool                      `json:"isOutdated"`
+	Comments   pullrequestreviewcomments `json:"comments"`
+}
+
+type pullrequestreviewthreads struct {
+	TotalCount int                       `json:"totalCount"`
+	PageInfo   pageInfo                  `json:"pageInfo"`
+	Nodes      []pullrequestreviewthread `json:"nodes"`
+}
+
+// pullRequestReviewThreadEvent is the pull_request_review_thread webhook which isn't supported by go-github yet
+// easyjson:skip
+type pullRequestReviewThreadEvent struct {
+	Action string `json:"action"`
+	Thread struct {
+		NodeID string `json:"node_id"`
+	} `json:"thread"`
+	PullRequest *github.PullRequest `json:"pull_request"`
+	Repo        *github.Repository  `json:"repository"`
+}
+
+// ToModel returns the comment on the diff of a pull request. the thread is identified by the first comment in the
+// thread since that is all a comment webhook knows about the thread
+func (c pullrequestreviewcomment) ToModel(logger sdk.Logger, userManager *UserManager, customerID string, repoID string, prID string, threadRefID string, resolved bool) (*sdk.SourceCodePullRequestComment, error) {
+	comment, err := pullrequestcomment{
+		ID:        c.ID,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+		Author:    c.Author,
+		URL:       c.URL,
+		Body:      c.Body,
+	}.ToModel(logger, userManager, customerID, repoID, prID)
+	if err != nil {
+		return nil, err
+	}
+	if c.Review != nil {
+		comment.ReviewID = sdk.NewSourceCodePullRequestReviewID(customerID, c.Review.ID, refType, repoID)
+	}
+	if c.ReplyTo != nil {
+		comment.ReplyToID = sdk.NewSourceCodePullRequestCommentID(customerID, c.ReplyTo.ID, refType, repoID)
+	}
+	comment.ThreadID = sdk.NewSourceCodePullRequestCommentID(customerID, threadRefID, refType, repoID)
+	comment.Path = c.Path
+	comment.DiffHunk = c.DiffHunk
+	if c.Line != nil {
+		comment.Line = *c.Line
+	}
+	if c.OriginalLine != nil {
+		comment.OriginalLine = *c.OriginalLine
+	}
+	comment.Outdated = c.Outdated
+	comment.Resolved = resolved
+	return comment, nil
+}
+
+// ThreadRefID returns the ref id of the first comment in the thread which the comment belongs to
+func (c pullrequestreviewcomment) ThreadRefID() string {
+	if c.ReplyTo != nil {
+		return c.ReplyTo.ID
+	}
+	return c.ID
+}
+
+// fetchReviewThreadComments returns the remaining comments in the thread after the cursor
+func (g *GithubIntegration) fetchReviewThreadComments(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, threadID string, cursor string) ([]pullrequestreviewcomment, error) {
+	variables := map[string]interface{}{
+		"id":    threadID,
+		"first": 100,
+		"after": cursor,
+	}
+	exec := g.newQueryExecutor(logger, client, control)
+	comments := make([]pullrequestreviewcomment, 0)
+	for {
+		var result struct {
+			RateLimit rateLimit `json:"rateLimit"`
+			Node      struct {
+				Comments pullrequestreviewcomments `json:"comments"`
+			} `json:"node"`
+		}
+		if err := exec.Query(reviewThreadCommentsQuery, variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching review thread comments: %w", err)
+		}
+		comments = append(comments, result.Node.Comments.Nodes...)
+		if !result.Node.Comments.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return nil, err
+		}
+		variables["after"] = result.Node.Comments.PageInfo.EndCursor
+	}
+	return comments, nil
+}
+
+// reviewThreadComments returns all the comments in the review thread, fetching any which weren't on the first page
+func (g *GithubIntegration) reviewThreadComments(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, customerID string, repoID string, prID string, thread pullrequestreviewthread) ([]*sdk.SourceCodePullRequestComment, error) {
+	nodes := thread.Comments.Nodes
+	if thread.Comments.PageInfo.HasNextPage {
+		more, err := g.fetchReviewThreadComments(logger, client, control, thread.ID, thread.Comments.PageInfo.EndCursor)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, more...)
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	threadRefID := nodes[0].ID
+	comments := make([]*sdk.SourceCodePullRequestComment, 0)
+	for _, node := range nodes {
+		comment, err := node.ToModel(logger, userManager, customerID, repoID, prID, threadRefID, thread.IsResolved)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, comment)
+	}
+	return comments, nil
+}
+
+func (g *GithubIntegration) queuePullRequestReviewThreadsJob(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, errs *exportErrors, repoName string, repoID string, prID string, prNumber int, cursor string) job {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	return func(export sdk.Export, pipe sdk.Pipe) error {
+		sdk.LogInfo(logger, "need to run a pull request review threads job starting from "+cursor, "name", repoName)
+		var variables = map[string]interface{}{
+			"first":  defaultPageSize,
+			"owner":  repoOwner,
+			"name":   repoLogin,
+			"number": prNumber,
+		}
+		if cursor != "" {
+			variables["after"] = cursor
+		}
+		customerID := export.CustomerID()
+		exec := g.newQueryExecutor(logger, client, export)
+		for {
+			sdk.LogDebug(logger, "running queued pullrequests review threads export", "number", prID, "repo", repoName, "after", variables["after"], "limit", variables["first"])
+			var result struct {
+				RateLimit  rateLimit `json:"rateLimit"`
+				Repository struct {
+					PullRequest struct {
+						ReviewThreads pullrequestreviewthreads `json:"reviewThreads"`
+					} `json:"pullRequest"`
+				} `json:"repository"`
+			}
+			if err := exec.Query(pullrequestReviewThreadsPagedQuery, variables, &result); err != nil {
+				return fmt.Errorf("error fetching pull request review threads: %w", err)
+			}
+			for _, thread := range result.Repository.PullRequest.ReviewThreads.Nodes {
+				comments, err := g.reviewThreadComments(logger, client, userManager, export, customerID, repoID, prID, thread)
+				if err != nil {
+					if isFatalExportError(err) {
+						return err
+					}
+					errs.Add(repoName, "pull request review thread", thread.ID, err)
+					continue
+				}
+				for _, comment := range comments {
+					if err := pipe.Write(comment); err != nil {
+						return err
+					}
+				}
+			}
+			if !result.Repository.PullRequest.ReviewThreads.PageInfo.HasNextPage {
+				break
+			}
+			if err := exec.checkRateLimit(result.RateLimit); err != nil {
+				return err
+			}
+			variables["after"] = result.Repository.PullRequest.ReviewThreads.PageInfo.EndCursor
+		}
+		return nil
+	}
+}
+
+// fromPullRequestReviewCommentEvent returns the comment which was changed. the comment is fetched so we have the
+// node ids of the review and the comment it replied to
+func (g *GithubIntegration) fromPullRequestReviewCommentEvent(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, customerID string, event *github.PullRequestReviewCommentEvent) (*sdk.SourceCodePullRequestComment, error) {
+	repoID := sdk.NewSourceCodeRepoID(customerID, event.GetRepo().GetNodeID(), refType)
+	prID := sdk.NewSourceCodePullRequestID(customerID, event.GetPullRequest().GetNodeID(), refType, repoID)
+	theComment := event.GetComment()
+	if event.GetAction() == "deleted" {
+		comment, err := pullrequestcomment{
+			ID:        theComment.GetNodeID(),
+			CreatedAt: theComment.GetCreatedAt(),
+			UpdatedAt: theComment.GetUpdatedAt(),
+			Author:    userToAuthor(theComment.GetUser()),
+			URL:       theComment.GetHTMLURL(),
+			Body:      toHTML(theComment.GetBody()),
+		}.ToModel(logger, userManager, customerID, repoID, prID)
+		if err != nil {
+			return nil, err
+		}
+		comment.Active = false
+		return comment, nil
+	}
+	var result struct {
+		Node *pullrequestreviewcomment `json:"node"`
+	}
+	if err := g.newQueryExecutor(logger, client, control).Query(reviewCommentQuery, map[string]interface{}{"id": theComment.GetNodeID()}, &result); err != nil {
+		return nil, fmt.Errorf("error fetching review comment %s: %w", theComment.GetNodeID(), err)
+	}
+	if result.Node == nil {
+		sdk.LogInfo(logger, "review comment not found", "id", theComment.GetNodeID())
+		return nil, nil
+	}
+	resolved := result.Node.Thread != nil && result.Node.Thread.IsResolved
+	return result.Node.ToModel(logger, userManager, customerID, repoID, prID, result.Node.ThreadRefID(), resolved)
+}
+
+// fromPullRequestReviewThreadEvent returns the comments in a thread which was resolved or unresolved
+func (g *GithubIntegration) fromPullRequestReviewThreadEvent(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, customerID string, event *pullRequestReviewThreadEvent) ([]*sdk.SourceCodePullRequestComment, error) {
+	repoID := sdk.NewSourceCodeRepoID(customerID, event.Repo.GetNodeID(), refType)
+	prID := sdk.NewSourceCodePullRequestID(customerID, event.PullRequest.GetNodeID(), refType, repoID)
+	var result struct {
+		Node *pullrequestreviewthread `json:"node"`
+	}
+	if err := g.newQueryExecutor(logger, client, control).Query(reviewThreadQuery, map[string]interface{}{"id": event.Thread.NodeID}, &result); err != nil {
+		return nil, fmt.Errorf("error fetching review thread %s: %w", event.Thread.NodeID, err)
+	}
+	if result.Node == nil {
+		sdk.LogInfo(logger, "review thread not found", "id", event.Thread.NodeID)
+		return nil, nil
+	}
+	return g.reviewThreadComments(logger, client, userManager, control, customerID, repoID, prID, *result.Node)
+}
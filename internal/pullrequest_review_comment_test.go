@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewCommentThreadRefID(t *testing.T) {
+	assert := assert.New(t)
+	var thread pullrequestreviewthread
+	assert.NoError(json.Unmarshal([]byte(`{"id":"thread","isResolved":true,"comments":{"nodes":[{"id":"first","path":"main.go","line":10},{"id":"second","replyTo":{"id":"first"},"pullRequestReview":{"id":"review"}}]}}`), &thread))
+	assert.True(thread.IsResolved)
+	assert.Len(thread.Comments.Nodes, 2)
+	assert.Equal("first", thread.Comments.Nodes[0].ThreadRefID())
+	assert.Equal("first", thread.Comments.Nodes[1].ThreadRefID())
+	assert.EqualValues(10, *thread.Comments.Nodes[0].Line)
+	assert.Equal("review", thread.Comments.Nodes[1].Review.ID)
+}
+
+func TestFromPullRequestReviewCommentEventResolved(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	client := &mockPagedGraphQLClient{
+		responses: []string{
+			`{"node":{"id":"second","replyTo":{"id":"first"},"pullRequestReview":{"id":"review"},"pullRequestReviewThread":{"isResolved":true}}}`,
+		},
+	}
+	event := &github.PullRequestReviewCommentEvent{
+		Action:      github.String("edited"),
+		Comment:     &github.PullRequestComment{NodeID: github.String("second")},
+		PullRequest: &github.PullRequest{NodeID: github.String("PR_1")},
+		Repo:        &github.Repository{NodeID: github.String("R_1")},
+	}
+	pipe := &mockPipe{}
+	comment, err := g.fromPullRequestReviewCommentEvent(sdk.NewNoOpTestLogger(), client, newMockUserManager(pipe), &mockControl{}, "1234", event)
+	assert.NoError(err)
+	assert.Equal("second", comment.RefID)
+	assert.True(comment.Resolved)
+	assert.Equal("second", client.variables[0]["id"])
+}
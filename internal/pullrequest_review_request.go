@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/go-github/v32/github"
@@ -19,31 +20,54 @@ type pullrequestreviewrequests struct {
 	Edges      []pullrequestreviewrequestsNode
 }
 
+// requestedReviewer is the user or team requested to review a pull request
+type requestedReviewer struct {
+	author
+	Slug         string `json:"slug"`
+	Organization struct {
+		Login string `json:"login"`
+	} `json:"organization"`
+}
+
 type pullrequestreviewrequest struct {
-	ID                string `json:"id"`
-	RequestedReviewer author `json:"requestedReviewer"`
+	ID                string            `json:"id"`
+	RequestedReviewer requestedReviewer `json:"requestedReviewer"`
 }
 
-func (g *GithubIntegration) fromPullRequestReviewRequestedEvent(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, customerID string, prEvent *github.PullRequestEvent) (*sdk.SourceCodePullRequestReviewRequest, error) {
+func (g *GithubIntegration) fromPullRequestReviewRequestedEvent(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, customerID string, prEvent *github.PullRequestEvent) ([]*sdk.SourceCodePullRequestReviewRequest, error) {
 	repoID := sdk.NewSourceCodeRepoID(customerID, prEvent.Repo.GetNodeID(), refType)
 	prID := sdk.NewSourceCodePullRequestID(customerID, prEvent.PullRequest.GetNodeID(), refType, repoID)
-	reviewer := prEvent.GetRequestedReviewer()
-	if reviewer == nil {
-		return nil, fmt.Errorf("error no requested reviewer for pr review request on pr %s", prID)
-	}
 	var updatedAt time.Time
 	if prEvent.PullRequest.UpdatedAt != nil {
 		updatedAt = *prEvent.PullRequest.UpdatedAt
 	}
-	// TODO(robin): figure out ref_id
-	reviewRequest := reviewRequest(customerID, userManager.instanceid, repoID, prID, *reviewer.NodeID, *prEvent.GetSender().NodeID, updatedAt)
-	if *prEvent.Action == "review_request_removed" {
-		reviewRequest.Active = false
+	senderRefID := prEvent.GetSender().GetNodeID()
+	var reviewRequests []*sdk.SourceCodePullRequestReviewRequest
+	if reviewer := prEvent.GetRequestedReviewer(); reviewer != nil {
+		// TODO(robin): figure out ref_id
+		reviewRequest := reviewRequest(customerID, userManager.instanceid, repoID, prID, reviewer.GetNodeID(), senderRefID, updatedAt)
+		if err := userManager.emitAuthor(logger, userToAuthor(reviewer)); err != nil {
+			return nil, err
+		}
+		reviewRequests = append(reviewRequests, &reviewRequest)
+	} else if team := prEvent.GetRequestedTeam(); team != nil {
+		org := getRepoOwnerLogin(prEvent.Repo)
+		// the event only has the api url for the team so build the html url from the repo
+		url := strings.TrimSuffix(prEvent.Repo.GetHTMLURL(), "/"+prEvent.Repo.GetFullName()) + "/orgs/" + org + "/teams/" + team.GetSlug()
+		teamRequests, err := userManager.teamReviewRequests(logger, client, repoID, prID, team.GetNodeID(), org, team.GetSlug(), team.GetName(), url, senderRefID, updatedAt)
+		if err != nil {
+			return nil, err
+		}
+		reviewRequests = append(reviewRequests, teamRequests...)
+	} else {
+		return nil, fmt.Errorf("error no requested reviewer for pr review request on pr %s", prID)
 	}
-	if err := userManager.emitAuthor(logger, userToAuthor(reviewer)); err != nil {
-		return nil, err
+	if *prEvent.Action == "review_request_removed" {
+		for _, reviewRequest := range reviewRequests {
+			reviewRequest.Active = false
+		}
 	}
-	return &reviewRequest, nil
+	return reviewRequests, nil
 }
 
 // reviewRequest sets everything
@@ -62,11 +86,39 @@ func reviewRequest(customerID string, integrationInstanceID string, repoID strin
 	}
 }
 
-func (r pullrequestreviewrequest) ToModel(logger sdk.Logger, userManager *UserManager, customerID string, repoID string, prID string, prUpdatedDate time.Time) (*sdk.SourceCodePullRequestReviewRequest, error) {
-	reviewRequest := reviewRequest(customerID, userManager.instanceid, repoID, prID, r.RequestedReviewer.ID, "", prUpdatedDate)
+// teamReviewRequests returns the review request for the team and, if enabled, a review request for each member of the team
+func (u *UserManager) teamReviewRequests(logger sdk.Logger, client sdk.GraphQLClient, repoID string, prID string, teamID string, org string, slug string, name string, url string, senderRefID string, updatedAt time.Time) ([]*sdk.SourceCodePullRequestReviewRequest, error) {
+	if err := u.emitTeam(logger, client, teamID, org, slug, name, url); err != nil {
+		return nil, err
+	}
+	teamRequest := reviewRequest(u.customerID, u.instanceid, repoID, prID, teamID, senderRefID, updatedAt)
+	reviewRequests := []*sdk.SourceCodePullRequestReviewRequest{&teamRequest}
+	if !u.expandTeams {
+		return reviewRequests, nil
+	}
+	members, err := u.teamMembers(logger, client, org, slug)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if err := u.emitAuthor(logger, member); err != nil {
+			return nil, err
+		}
+		memberRequest := reviewRequest(u.customerID, u.instanceid, repoID, prID, member.ID, senderRefID, updatedAt)
+		reviewRequests = append(reviewRequests, &memberRequest)
+	}
+	return reviewRequests, nil
+}
+
+func (r pullrequestreviewrequest) ToModel(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, customerID string, repoID string, prID string, prUpdatedDate time.Time) ([]*sdk.SourceCodePullRequestReviewRequest, error) {
+	reviewer := r.RequestedReviewer
+	if reviewer.Type == "Team" {
+		return userManager.teamReviewRequests(logger, client, repoID, prID, reviewer.ID, reviewer.Organization.Login, reviewer.Slug, reviewer.Name, reviewer.URL, "", prUpdatedDate)
+	}
+	reviewRequest := reviewRequest(customerID, userManager.instanceid, repoID, prID, reviewer.ID, "", prUpdatedDate)
 	// TODO(robin): figure out ref_id
-	if err := userManager.emitAuthor(logger, r.RequestedReviewer); err != nil {
+	if err := userManager.emitAuthor(logger, reviewer.author); err != nil {
 		return nil, err
 	}
-	return &reviewRequest, nil
+	return []*sdk.SourceCodePullRequestReviewRequest{&reviewRequest}, nil
 }
@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueuePullRequestReviewRequestsJob(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	client := &mockPagedGraphQLClient{
+		responses: []string{
+			`{"rateLimit":{"limit":5000,"remaining":4999},"repository":{"pullRequest":{"reviewRequests":{"pageInfo":{"hasNextPage":true,"endCursor":"r2"},"edges":[{"node":{"id":"RR_1","requestedReviewer":{"type":"User","id":"U_1","login":"jhaynie"}}}]}}}}`,
+			`{"rateLimit":{"limit":5000,"remaining":4998},"repository":{"pullRequest":{"reviewRequests":{"pageInfo":{"hasNextPage":false,"endCursor":"r3"},"edges":[{"node":{"id":"RR_2","requestedReviewer":{"type":"User","id":"U_2","login":"robindiddams"}}},{"node":{"id":"RR_3","requestedReviewer":{"type":"Team","id":"T_1","slug":"eng","name":"Engineering","organization":{"login":"pinpt"}}}}]}}}}`,
+			`{"organization":{"team":{"id":"T_1","name":"Engineering","slug":"eng","members":{"nodes":[{"type":"User","id":"U_1","login":"jhaynie"}]}}}}`,
+		},
+	}
+	pipe := &mockPipe{}
+	job := g.queuePullRequestReviewRequestsJob(sdk.NewNoOpTestLogger(), client, newMockUserManager(pipe), "pinpt/agent", "R_1", "PR_1", 5, time.Now(), "r1")
+	assert.NoError(job(&mockExport{}, pipe))
+	assert.Len(client.variables, 3)
+	assert.Equal("r1", client.variables[0]["after"])
+	assert.Equal("r2", client.variables[1]["after"])
+	assert.Equal(5, client.variables[1]["number"])
+	assert.Equal("eng", client.variables[2]["slug"])
+	reviewers := make([]string, 0)
+	teams := make([]string, 0)
+	for _, record := range pipe.records {
+		switch r := record.(type) {
+		case *sdk.SourceCodePullRequestReviewRequest:
+			assert.Equal("R_1", r.RepoID)
+			assert.Equal("PR_1", r.PullRequestID)
+			assert.True(r.Active)
+			reviewers = append(reviewers, r.RequestedReviewerRefID)
+		case *sdk.SourceCodeTeam:
+			assert.Equal([]string{"U_1"}, r.MemberRefIds)
+			teams = append(teams, r.RefID)
+		case *sdk.SourceCodeUser:
+			assert.NotEqual("T_1", r.RefID)
+		}
+	}
+	assert.Equal([]string{"U_1", "U_2", "T_1"}, reviewers)
+	assert.Equal([]string{"T_1"}, teams)
+}
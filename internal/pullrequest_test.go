@@ -0,0 +1,20 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequestToModelClosedDate(t *testing.T) {
+	assert := assert.New(t)
+	var pr pullrequest
+	assert.NoError(json.Unmarshal([]byte(`{"id":"PR_1","number":1,"state":"CLOSED","closed":true,"createdAt":"2020-10-01T10:00:00Z","updatedAt":"2020-10-05T10:00:00Z","closedAt":"2020-10-02T10:00:00Z","author":{"type":"User","id":"U_1","login":"jhaynie"}}`), &pr))
+	pullrequest, err := pr.ToModel(sdk.NewNoOpTestLogger(), newMockUserManager(&mockPipe{}), "1234", "pinpt/agent", "R_1")
+	assert.NoError(err)
+	assert.Equal(sdk.SourceCodePullRequestStatusClosed, pullrequest.Status)
+	assert.Equal(pr.ClosedAt.UnixNano()/1e6, pullrequest.ClosedDate.Epoch)
+	assert.NotEqual(pr.UpdatedAt.UnixNano()/1e6, pullrequest.ClosedDate.Epoch)
+}
@@ -0,0 +1,122 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+type pullrequestTimelineItem struct {
+	Type      string    `json:"type"`
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	Actor     *author   `json:"actor"`
+}
+
+type pullrequestTimelineItems struct {
+	PageInfo pageInfo                  `json:"pageInfo"`
+	Nodes    []pullrequestTimelineItem `json:"nodes"`
+}
+
+var pullrequestEventTypes = map[string]sdk.SourceCodePullRequestEventsType{
+	"ReadyForReviewEvent":     sdk.SourceCodePullRequestEventsTypeReadyForReview,
+	"ConvertToDraftEvent":     sdk.SourceCodePullRequestEventsTypeConvertedToDraft,
+	"ReviewRequestedEvent":    sdk.SourceCodePullRequestEventsTypeReviewRequested,
+	"HeadRefForcePushedEvent": sdk.SourceCodePullRequestEventsTypeHeadRefForcePushed,
+	"ReopenedEvent":           sdk.SourceCodePullRequestEventsTypeReopened,
+	"ClosedEvent":             sdk.SourceCodePullRequestEventsTypeClosed,
+	"MergedEvent":             sdk.SourceCodePullRequestEventsTypeMerged,
+	"BaseRefChangedEvent":     sdk.SourceCodePullRequestEventsTypeBaseRefChanged,
+}
+
+// ToModel returns the event for the timeline item or nil if it isn't an event we track
+func (t pullrequestTimelineItem) ToModel(logger sdk.Logger, userManager *UserManager, customerID string) (*sdk.SourceCodePullRequestEvents, error) {
+	eventType, ok := pullrequestEventTypes[t.Type]
+	if !ok {
+		return nil, nil
+	}
+	event := &sdk.SourceCodePullRequestEvents{
+		RefID: t.ID,
+		Type:  eventType,
+	}
+	sdk.ConvertTimeToDateModel(t.CreatedAt, &event.CreatedDate)
+	if t.Actor != nil {
+		event.UserRefID = t.Actor.RefID(customerID)
+		if err := userManager.emitAuthor(logger, *t.Actor); err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
+}
+
+// fetchPullRequestTimeline returns the timeline of the pull request after the cursor
+func (g *GithubIntegration) fetchPullRequestTimeline(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, prID string, cursor string) ([]pullrequestTimelineItem, error) {
+	variables := map[string]interface{}{
+		"id":    prID,
+		"first": 100,
+	}
+	if cursor != "" {
+		variables["after"] = cursor
+	}
+	exec := g.newQueryExecutor(logger, client, control)
+	items := make([]pullrequestTimelineItem, 0)
+	for {
+		var result struct {
+			RateLimit rateLimit `json:"rateLimit"`
+			Node      struct {
+				TimelineItems pullrequestTimelineItems `json:"timelineItems"`
+			} `json:"node"`
+		}
+		if err := exec.Query(pullrequestTimelinePagedQuery, variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching pull request timeline: %w", err)
+		}
+		items = append(items, result.Node.TimelineItems.Nodes...)
+		if !result.Node.TimelineItems.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return nil, err
+		}
+		variables["after"] = result.Node.TimelineItems.PageInfo.EndCursor
+	}
+	return items, nil
+}
+
+// setPullRequestEvents sets the lifecycle events on the pull request from the timeline, fetching any of the timeline
+// which wasn't on the first page. a closed pull request also gets who closed it and when from the last close event
+func (g *GithubIntegration) setPullRequestEvents(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, pullrequest *sdk.SourceCodePullRequest, timeline pullrequestTimelineItems) error {
+	// leave the events empty, keeping the dates from the pull request, if we can't fetch all of them
+	pullrequest.Events = make([]sdk.SourceCodePullRequestEvents, 0)
+	nodes := timeline.Nodes
+	if timeline.PageInfo.HasNextPage {
+		more, err := g.fetchPullRequestTimeline(logger, client, control, pullrequest.RefID, timeline.PageInfo.EndCursor)
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, more...)
+	}
+	events := make([]sdk.SourceCodePullRequestEvents, 0)
+	var closed *pullrequestTimelineItem
+	var closedBy string
+	for i, node := range nodes {
+		event, err := node.ToModel(logger, userManager, pullrequest.CustomerID)
+		if err != nil {
+			return err
+		}
+		if event == nil {
+			continue
+		}
+		events = append(events, *event)
+		if event.Type == sdk.SourceCodePullRequestEventsTypeClosed {
+			closed = &nodes[i]
+			closedBy = event.UserRefID
+		}
+	}
+	pullrequest.Events = events
+	if closed != nil && pullrequest.Status == sdk.SourceCodePullRequestStatusClosed {
+		pullrequest.ClosedByRefID = closedBy
+		sdk.ConvertTimeToDateModel(closed.CreatedAt, &pullrequest.ClosedDate)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullRequestTimelineItemToModel(t *testing.T) {
+	assert := assert.New(t)
+	event, err := pullrequestTimelineItem{Type: "ConvertToDraftEvent", ID: "1"}.ToModel(nil, nil, "1234")
+	assert.NoError(err)
+	assert.Equal(sdk.SourceCodePullRequestEventsTypeConvertedToDraft, event.Type)
+	assert.Equal("1", event.RefID)
+	assert.Empty(event.UserRefID)
+
+	event, err = pullrequestTimelineItem{Type: "LabeledEvent", ID: "2"}.ToModel(nil, nil, "1234")
+	assert.NoError(err)
+	assert.Nil(event)
+}
+
+func TestSetPullRequestEvents(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	userManager := newMockUserManager(&mockPipe{})
+	timeline := pullrequestTimelineItems{Nodes: []pullrequestTimelineItem{{Type: "ReadyForReviewEvent", ID: "1"}, {Type: "ClosedEvent", ID: "2", Actor: &author{ID: "U_1", authorCommon: authorCommon{Type: "User"}}}}}
+	pullrequest := &sdk.SourceCodePullRequest{Status: sdk.SourceCodePullRequestStatusClosed, ClosedByRefID: "U_2"}
+	assert.NoError(g.setPullRequestEvents(nil, &mockPagedGraphQLClient{}, userManager, &mockControl{}, pullrequest, timeline))
+	assert.Len(pullrequest.Events, 2)
+	assert.Equal("U_1", pullrequest.ClosedByRefID)
+
+	// a timeline which can't be fetched leaves the pull request without events
+	timeline.PageInfo.HasNextPage = true
+	pullrequest = &sdk.SourceCodePullRequest{Status: sdk.SourceCodePullRequestStatusClosed, ClosedByRefID: "U_2"}
+	assert.Error(g.setPullRequestEvents(nil, &mockPagedGraphQLClient{}, userManager, &mockControl{}, pullrequest, timeline))
+	assert.NotNil(pullrequest.Events)
+	assert.Empty(pullrequest.Events)
+	assert.Equal("U_2", pullrequest.ClosedByRefID)
+}
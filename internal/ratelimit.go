@@ -0,0 +1,347 @@
+package internal
+
+import (
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+const (
+	// rateLimitPauseThreshold is the fraction of the limit we keep in reserve, we pause when we get below it
+	rateLimitPauseThreshold = 0.2
+	// rateLimitThrottleThreshold is the fraction of the limit remaining where we start to spread out requests
+	rateLimitThrottleThreshold = 0.5
+	// rateLimitPauseReportMin is the min time we will sleep before we report the control as paused
+	rateLimitPauseReportMin = time.Second * 30
+	defaultQueryCost        = 1
+)
+
+// rateLimitBucket is the last known state of one of the GitHub rate limits
+// easyjson:skip
+type rateLimitBucket struct {
+	limit     int
+	remaining int
+	resetAt   time.Time
+}
+
+func (b *rateLimitBucket) known() bool {
+	return b.limit > 0
+}
+
+func (b *rateLimitBucket) update(limit, remaining int, resetAt time.Time) {
+	b.limit = limit
+	b.remaining = remaining
+	b.resetAt = resetAt
+}
+
+// available returns the number of points we can spend before we hit our reserve
+func (b *rateLimitBucket) available(now time.Time) float64 {
+	if now.After(b.resetAt) {
+		b.remaining = b.limit // the window has reset
+	}
+	return float64(b.remaining) - float64(b.limit)*rateLimitPauseThreshold
+}
+
+// delay returns how long a query with cost should wait and if the wait is because the budget is exhausted
+func (b *rateLimitBucket) delay(now time.Time, cost float64) (time.Duration, bool) {
+	if !b.known() {
+		return 0, false
+	}
+	available := b.available(now)
+	if available < cost {
+		return b.resetAt.Sub(now), true
+	}
+	if float64(b.remaining) > float64(b.limit)*rateLimitThrottleThreshold {
+		return 0, false
+	}
+	// spread the remaining budget evenly over the time left in the window
+	calls := available / cost
+	return time.Duration(float64(b.resetAt.Sub(now)) / calls), false
+}
+
+// rateLimitBudget tracks the GraphQL and REST rate limits for all the clients of an integration instance
+// and will slow down callers before GitHub starts to reject our requests
+// easyjson:skip
+type rateLimitBudget struct {
+	mu          sync.Mutex
+	cond        *sync.Cond
+	concurrency int
+	inflight    int
+	graphql     rateLimitBucket
+	rest        rateLimitBucket
+	costs       map[string]float64
+	lastCost    float64
+	pausedUntil time.Time
+}
+
+func newRateLimitBudget() *rateLimitBudget {
+	b := &rateLimitBudget{
+		concurrency: 1,
+		costs:       make(map[string]float64),
+		lastCost:    defaultQueryCost,
+	}
+	b.cond = sync.NewCond(&b.mu)
+	return b
+}
+
+func (g *GithubIntegration) getRateLimitBudget(integrationInstanceID string) *rateLimitBudget {
+	g.budgetLock.Lock()
+	defer g.budgetLock.Unlock()
+	if g.budgets == nil {
+		g.budgets = make(map[string]*rateLimitBudget)
+	}
+	budget := g.budgets[integrationInstanceID]
+	if budget == nil {
+		budget = newRateLimitBudget()
+		g.budgets[integrationInstanceID] = budget
+	}
+	return budget
+}
+
+var queryNameRegexp = regexp.MustCompile(`^\s*(?:query|mutation)\s+(\w+)`)
+
+// queryKey returns the key used to remember the cost of a query
+func queryKey(query string) string {
+	if m := queryNameRegexp.FindStringSubmatch(query); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// predictCost returns the expected cost of the next query for key
+func (b *rateLimitBudget) predictCost(key string) float64 {
+	if cost, ok := b.costs[key]; ok && key != "" {
+		return cost
+	}
+	return b.lastCost
+}
+
+// updateGraphQL records the rate limit returned in the response of the query for key
+func (b *rateLimitBudget) updateGraphQL(key string, rl rateLimit) {
+	if rl.Limit == 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.graphql.update(rl.Limit, rl.Remaining, rl.ResetAt)
+	cost := float64(rl.Cost)
+	if cost < defaultQueryCost {
+		cost = defaultQueryCost
+	}
+	if prev, ok := b.costs[key]; ok && key != "" {
+		cost = (prev + cost) / 2
+	}
+	if key != "" {
+		b.costs[key] = cost
+	}
+	b.lastCost = cost
+}
+
+// updateREST records the X-RateLimit-* headers returned by a REST call
+func (b *rateLimitBudget) updateREST(headers http.Header) {
+	if headers == nil {
+		return
+	}
+	limit, err := strconv.Atoi(headers.Get("X-RateLimit-Limit"))
+	if err != nil || limit == 0 {
+		return
+	}
+	remaining, _ := strconv.Atoi(headers.Get("X-RateLimit-Remaining"))
+	reset, _ := strconv.ParseInt(headers.Get("X-RateLimit-Reset"), 10, 64)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch headers.Get("X-RateLimit-Resource") {
+	case "graphql":
+		b.graphql.update(limit, remaining, time.Unix(reset, 0))
+	case "", "core":
+		b.rest.update(limit, remaining, time.Unix(reset, 0))
+	}
+}
+
+// reserve will claim the predicted cost of the next request from the bucket, returning how long the
+// caller must wait first and if this wait is due to the budget being exhausted
+func (b *rateLimitBudget) reserve(bucket *rateLimitBucket, cost float64) (time.Duration, time.Time, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	d, exhausted := bucket.delay(now, cost)
+	if !exhausted {
+		// count it now so that concurrent callers see it before the response comes back
+		bucket.remaining -= int(cost)
+	}
+	resetAt := bucket.resetAt
+	// only the first caller to hit an exhausted budget will report the pause
+	report := exhausted && d >= rateLimitPauseReportMin && b.pausedUntil.Before(resetAt)
+	if report {
+		b.pausedUntil = resetAt
+	}
+	return d, resetAt, report
+}
+
+func (b *rateLimitBudget) wait(logger sdk.Logger, control sdk.Control, bucket *rateLimitBucket, cost float64) error {
+	d, resetAt, report := b.reserve(bucket, cost)
+	if d <= 0 {
+		return nil
+	}
+	if !report || control == nil {
+		sdk.LogDebug(logger, "rate limit budget is low, slowing down", "delay", d, "cost", cost)
+		sleep(d)
+		return nil
+	}
+	sdk.LogInfo(logger, "rate limit budget exhausted, pausing", "until", resetAt)
+	if err := control.Paused(resetAt); err != nil {
+		return err
+	}
+	sleep(d)
+	sdk.LogInfo(logger, "rate limit budget reset, resuming")
+	return control.Resumed()
+}
+
+// setConcurrency sets the max number of GraphQL requests which can run at the same time
+func (b *rateLimitBudget) setConcurrency(concurrency int) {
+	b.mu.Lock()
+	b.concurrency = concurrency
+	b.mu.Unlock()
+	b.cond.Broadcast()
+}
+
+// allowedConcurrency returns the number of requests which can run at the same time. once the budget
+// is running low we go back to one at a time
+func (b *rateLimitBudget) allowedConcurrency() int {
+	if b.concurrency < 1 {
+		return 1
+	}
+	if b.graphql.known() && float64(b.graphql.remaining) <= float64(b.graphql.limit)*rateLimitThrottleThreshold {
+		return 1
+	}
+	return b.concurrency
+}
+
+// acquire will block until another GraphQL request can be started, release must be called once it's done
+func (b *rateLimitBudget) acquire() {
+	b.mu.Lock()
+	for b.inflight >= b.allowedConcurrency() {
+		b.cond.Wait()
+	}
+	b.inflight++
+	b.mu.Unlock()
+}
+
+func (b *rateLimitBudget) release() {
+	b.mu.Lock()
+	b.inflight--
+	b.mu.Unlock()
+	b.cond.Broadcast()
+}
+
+// waitGraphQL will block until the budget can afford the query
+func (b *rateLimitBudget) waitGraphQL(logger sdk.Logger, control sdk.Control, query string) error {
+	b.mu.Lock()
+	cost := b.predictCost(queryKey(query))
+	b.mu.Unlock()
+	return b.wait(logger, control, &b.graphql, cost)
+}
+
+// waitREST will block until the budget can afford a REST call
+func (b *rateLimitBudget) waitREST(logger sdk.Logger, control sdk.Control) error {
+	return b.wait(logger, control, &b.rest, defaultQueryCost)
+}
+
+// rateLimitBudgeted is implemented by clients which share a rate limit budget
+type rateLimitBudgeted interface {
+	getBudget() *rateLimitBudget
+}
+
+// easyjson:skip
+type budgetGraphQLClient struct {
+	sdk.GraphQLClient
+	logger  sdk.Logger
+	control sdk.Control
+	budget  *rateLimitBudget
+}
+
+var _ sdk.GraphQLClient = (*budgetGraphQLClient)(nil)
+var _ rateLimitBudgeted = (*budgetGraphQLClient)(nil)
+
+func (c *budgetGraphQLClient) getBudget() *rateLimitBudget {
+	return c.budget
+}
+
+func (c *budgetGraphQLClient) Query(query string, variables map[string]interface{}, out interface{}) error {
+	if err := c.budget.waitGraphQL(c.logger, c.control, query); err != nil {
+		return err
+	}
+	c.budget.acquire()
+	defer c.budget.release()
+	return c.GraphQLClient.Query(query, variables, out)
+}
+
+func (c *budgetGraphQLClient) Mutate(query string, variables map[string]interface{}, out interface{}) error {
+	if err := c.budget.waitGraphQL(c.logger, c.control, query); err != nil {
+		return err
+	}
+	c.budget.acquire()
+	defer c.budget.release()
+	return c.GraphQLClient.Mutate(query, variables, out)
+}
+
+// graphQLClient returns a client which will account for its queries in the budget. control may be nil
+func (b *rateLimitBudget) graphQLClient(logger sdk.Logger, control sdk.Control, client sdk.GraphQLClient) sdk.GraphQLClient {
+	return &budgetGraphQLClient{client, logger, control, b}
+}
+
+// easyjson:skip
+type budgetHTTPClient struct {
+	client  sdk.HTTPClient
+	logger  sdk.Logger
+	control sdk.Control
+	budget  *rateLimitBudget
+}
+
+var _ sdk.HTTPClient = (*budgetHTTPClient)(nil)
+var _ rateLimitBudgeted = (*budgetHTTPClient)(nil)
+
+func (c *budgetHTTPClient) getBudget() *rateLimitBudget {
+	return c.budget
+}
+
+func (c *budgetHTTPClient) do(fn func() (*sdk.HTTPResponse, error)) (*sdk.HTTPResponse, error) {
+	if err := c.budget.waitREST(c.logger, c.control); err != nil {
+		return nil, err
+	}
+	resp, err := fn()
+	if resp != nil {
+		c.budget.updateREST(resp.Headers)
+	}
+	return resp, err
+}
+
+func (c *budgetHTTPClient) Get(out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+	return c.do(func() (*sdk.HTTPResponse, error) { return c.client.Get(out, options...) })
+}
+
+func (c *budgetHTTPClient) Post(data io.Reader, out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+	return c.do(func() (*sdk.HTTPResponse, error) { return c.client.Post(data, out, options...) })
+}
+
+func (c *budgetHTTPClient) Put(data io.Reader, out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+	return c.do(func() (*sdk.HTTPResponse, error) { return c.client.Put(data, out, options...) })
+}
+
+func (c *budgetHTTPClient) Patch(data io.Reader, out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+	return c.do(func() (*sdk.HTTPResponse, error) { return c.client.Patch(data, out, options...) })
+}
+
+func (c *budgetHTTPClient) Delete(out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+	return c.do(func() (*sdk.HTTPResponse, error) { return c.client.Delete(out, options...) })
+}
+
+// httpClient returns a client which will account for its requests in the budget. control may be nil
+func (b *rateLimitBudget) httpClient(logger sdk.Logger, control sdk.Control, client sdk.HTTPClient) sdk.HTTPClient {
+	return &budgetHTTPClient{client, logger, control, b}
+}
@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitShouldPause(t *testing.T) {
+	assert := assert.New(t)
+	assert.False(rateLimit{Limit: 5000, Remaining: 5000}.ShouldPause())
+	assert.False(rateLimit{Limit: 5000, Remaining: 1001}.ShouldPause())
+	assert.True(rateLimit{Limit: 5000, Remaining: 1000}.ShouldPause())
+	assert.True(rateLimit{Limit: 5000, Remaining: 0}.ShouldPause())
+}
+
+func TestRateLimitBucketDelay(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	var b rateLimitBucket
+	d, exhausted := b.delay(now, 10)
+	assert.Equal(time.Duration(0), d)
+	assert.False(exhausted)
+
+	b.update(5000, 4000, now.Add(time.Hour))
+	d, exhausted = b.delay(now, 10)
+	assert.Equal(time.Duration(0), d)
+	assert.False(exhausted)
+
+	// 2000 remaining with 1000 in reserve, spread 100 calls over the hour
+	b.update(5000, 2000, now.Add(time.Hour))
+	d, exhausted = b.delay(now, 10)
+	assert.Equal(time.Hour/100, d)
+	assert.False(exhausted)
+
+	b.update(5000, 1005, now.Add(time.Hour))
+	d, exhausted = b.delay(now, 10)
+	assert.Equal(time.Hour, d)
+	assert.True(exhausted)
+
+	// once the window resets we have the full limit again
+	b.update(5000, 0, now.Add(-time.Second))
+	d, exhausted = b.delay(now, 10)
+	assert.Equal(time.Duration(0), d)
+	assert.False(exhausted)
+}
+
+func TestRateLimitBudgetUpdate(t *testing.T) {
+	assert := assert.New(t)
+	b := newRateLimitBudget()
+	assert.Equal(float64(defaultQueryCost), b.predictCost("GetPullRequests"))
+	b.updateGraphQL("GetPullRequests", rateLimit{Limit: 5000, Remaining: 4990, Cost: 10})
+	assert.Equal(float64(10), b.predictCost("GetPullRequests"))
+	b.updateGraphQL("GetPullRequests", rateLimit{Limit: 5000, Remaining: 4970, Cost: 30})
+	assert.Equal(float64(20), b.predictCost("GetPullRequests"))
+	assert.Equal(4970, b.graphql.remaining)
+	assert.Equal(float64(20), b.predictCost(""))
+
+	headers := http.Header{}
+	headers.Set("X-RateLimit-Limit", "5000")
+	headers.Set("X-RateLimit-Remaining", "4321")
+	headers.Set("X-RateLimit-Reset", "1600000000")
+	b.updateREST(headers)
+	assert.Equal(5000, b.rest.limit)
+	assert.Equal(4321, b.rest.remaining)
+	assert.Equal(time.Unix(1600000000, 0), b.rest.resetAt)
+	assert.Equal(4970, b.graphql.remaining)
+}
+
+func TestQueryKey(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("GetPullRequests", queryKey(pullrequestPagedQuery))
+	assert.Equal("", queryKey("query { viewer { login } }"))
+}
@@ -0,0 +1,305 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+type release struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	TagName      string     `json:"tagName"`
+	Description  string     `json:"description"`
+	URL          string     `json:"url"`
+	IsDraft      bool       `json:"isDraft"`
+	IsPrerelease bool       `json:"isPrerelease"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	PublishedAt  *time.Time `json:"publishedAt"`
+	Author       *author    `json:"author"`
+	TagCommit    *struct {
+		Oid string `json:"oid"`
+	} `json:"tagCommit"`
+}
+
+type tag struct {
+	Name   string `json:"name"`
+	Target struct {
+		Type          string    `json:"type"`
+		Oid           string    `json:"oid"`
+		CommittedDate time.Time `json:"committedDate"`
+		Tagger        *struct {
+			Date time.Time `json:"date"`
+		} `json:"tagger"`
+		Target *struct {
+			Oid           string    `json:"oid"`
+			CommittedDate time.Time `json:"committedDate"`
+		} `json:"target"`
+	} `json:"target"`
+}
+
+// Sha returns the sha of the commit for the tag, which for an annotated tag is the target of the tag object
+func (t tag) Sha() string {
+	if t.Target.Type == "Tag" && t.Target.Target != nil {
+		return t.Target.Target.Oid
+	}
+	return t.Target.Oid
+}
+
+// Date returns the date the tag was created, falling back to the commit date for a lightweight tag
+func (t tag) Date() time.Time {
+	if t.Target.Tagger != nil {
+		return t.Target.Tagger.Date
+	}
+	return t.Target.CommittedDate
+}
+
+// CommitDate returns the date of the commit for the tag which is what the tags are ordered by
+func (t tag) CommitDate() time.Time {
+	if t.Target.Type == "Tag" && t.Target.Target != nil {
+		return t.Target.Target.CommittedDate
+	}
+	return t.Target.CommittedDate
+}
+
+type releasesResult struct {
+	RateLimit  rateLimit `json:"rateLimit"`
+	Repository struct {
+		Releases struct {
+			PageInfo pageInfo  `json:"pageInfo"`
+			Nodes    []release `json:"nodes"`
+		} `json:"releases"`
+	} `json:"repository"`
+}
+
+type tagsResult struct {
+	RateLimit  rateLimit `json:"rateLimit"`
+	Repository struct {
+		Refs struct {
+			PageInfo pageInfo `json:"pageInfo"`
+			Nodes    []tag    `json:"nodes"`
+		} `json:"refs"`
+	} `json:"repository"`
+}
+
+// newReleaseModel returns a release for the tag. the tag name is used as the ref id so that a tag and the release
+// created for it are the same entity
+func newReleaseModel(customerID string, integrationInstanceID string, repoID string, tagName string, sha string) *sdk.SourceCodeRelease {
+	release := &sdk.SourceCodeRelease{}
+	release.CustomerID = customerID
+	release.RefType = refType
+	release.RefID = tagName
+	release.RepoID = repoID
+	release.ID = sdk.NewSourceCodeReleaseID(customerID, tagName, refType, repoID)
+	release.IntegrationInstanceID = sdk.StringPointer(integrationInstanceID)
+	release.Tag = tagName
+	release.Name = tagName
+	release.Active = true
+	if sha != "" {
+		release.CommitSha = sha
+		release.CommitID = sdk.NewSourceCodeCommitID(customerID, sha, refType, repoID)
+	}
+	release.UpdatedAt = sdk.EpochNow()
+	return release
+}
+
+func (r release) ToModel(logger sdk.Logger, userManager *UserManager, customerID string, repoID string) (*sdk.SourceCodeRelease, error) {
+	var sha string
+	if r.TagCommit != nil {
+		sha = r.TagCommit.Oid
+	}
+	release := newReleaseModel(customerID, userManager.instanceid, repoID, r.TagName, sha)
+	if r.Name != "" {
+		release.Name = r.Name
+	}
+	release.Description = toHTML(r.Description)
+	release.URL = r.URL
+	release.Draft = r.IsDraft
+	release.Prerelease = r.IsPrerelease
+	if r.PublishedAt != nil {
+		sdk.ConvertTimeToDateModel(*r.PublishedAt, &release.PublishedDate)
+	}
+	if r.Author != nil {
+		release.AuthorRefID = r.Author.RefID(customerID)
+		if err := userManager.emitAuthor(logger, *r.Author); err != nil {
+			return nil, err
+		}
+	}
+	return release, nil
+}
+
+func (t tag) ToModel(customerID string, integrationInstanceID string, repoID string, repoURL string) *sdk.SourceCodeRelease {
+	release := newReleaseModel(customerID, integrationInstanceID, repoID, t.Name, t.Sha())
+	release.URL = repoURL + "/releases/tag/" + t.Name
+	sdk.ConvertTimeToDateModel(t.Date(), &release.PublishedDate)
+	return release
+}
+
+func (g *GithubIntegration) getReleasesKey(repoName string) string {
+	return fmt.Sprintf("releases_%s", repoName)
+}
+
+// exportReleases will export the releases and the tags which don't have a release for the repo. in an incremental
+// only the releases and tags created since the previous export are exported. the tags are ordered by the date of their
+// commit so a new tag on a commit from before the previous export comes from the create webhook
+func (g *GithubIntegration) exportReleases(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, errs *exportErrors, export sdk.Export, repoName string, repoID string, repoURL string, historical bool) error {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	customerID := export.CustomerID()
+	pipe := export.Pipe()
+	state := export.State()
+	exec := g.newQueryExecutor(logger, client, export)
+	started := time.Now()
+	var since time.Time
+	if !historical {
+		state.Get(g.getReleasesKey(repoName), &since)
+	}
+	tags := make(map[string]bool)
+	variables := map[string]interface{}{
+		"first": defaultPageSize,
+		"owner": repoOwner,
+		"name":  repoLogin,
+	}
+	for {
+		sdk.LogDebug(logger, "running fetch releases", "name", repoName, "after", variables["after"])
+		var result releasesResult
+		if err := exec.Query(releasesQuery, variables, &result); err != nil {
+			return fmt.Errorf("error fetching releases for %s: %w", repoName, err)
+		}
+		done := !result.Repository.Releases.PageInfo.HasNextPage
+		for _, node := range result.Repository.Releases.Nodes {
+			if node.CreatedAt.Before(since) {
+				done = true
+				break
+			}
+			tags[node.TagName] = true
+			release, err := node.ToModel(logger, userManager, customerID, repoID)
+			if err != nil {
+				errs.Add(repoName, "release", node.ID, err)
+				continue
+			}
+			if err := pipe.Write(release); err != nil {
+				return err
+			}
+		}
+		if done {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return err
+		}
+		variables["after"] = result.Repository.Releases.PageInfo.EndCursor
+	}
+	delete(variables, "after")
+	for {
+		sdk.LogDebug(logger, "running fetch tags", "name", repoName, "after", variables["after"])
+		var result tagsResult
+		if err := exec.Query(tagsQuery, variables, &result); err != nil {
+			return fmt.Errorf("error fetching tags for %s: %w", repoName, err)
+		}
+		done := !result.Repository.Refs.PageInfo.HasNextPage
+		for _, node := range result.Repository.Refs.Nodes {
+			if node.CommitDate().Before(since) {
+				done = true
+				break
+			}
+			if tags[node.Name] || node.Date().Before(since) {
+				// already exported as a release or in a previous export
+				continue
+			}
+			if err := pipe.Write(node.ToModel(customerID, export.IntegrationInstanceID(), repoID, repoURL)); err != nil {
+				return err
+			}
+		}
+		if done {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return err
+		}
+		variables["after"] = result.Repository.Refs.PageInfo.EndCursor
+	}
+	return state.Set(g.getReleasesKey(repoName), started)
+}
+
+// fromReleaseEvent returns the release which was changed. a deleted release is returned as its tag if the tag still exists
+func (g *GithubIntegration) fromReleaseEvent(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, customerID string, event *github.ReleaseEvent) (*sdk.SourceCodeRelease, error) {
+	theRelease := event.GetRelease()
+	repoID := sdk.NewSourceCodeRepoID(customerID, event.GetRepo().GetNodeID(), refType)
+	var r release
+	r.ID = theRelease.GetNodeID()
+	r.Name = theRelease.GetName()
+	r.TagName = theRelease.GetTagName()
+	r.Description = theRelease.GetBody()
+	r.URL = theRelease.GetHTMLURL()
+	r.IsDraft = theRelease.GetDraft()
+	r.IsPrerelease = theRelease.GetPrerelease()
+	r.CreatedAt = theRelease.GetCreatedAt().Time
+	if theRelease.PublishedAt != nil {
+		r.PublishedAt = &theRelease.PublishedAt.Time
+	}
+	if theRelease.Author != nil {
+		a := userToAuthor(theRelease.Author)
+		r.Author = &a
+	}
+	release, err := r.ToModel(logger, userManager, customerID, repoID)
+	if err != nil {
+		return nil, err
+	}
+	if event.GetAction() == "deleted" {
+		t, _, err := g.fetchTag(logger, client, control, event.GetRepo(), r.TagName)
+		if err != nil {
+			return nil, err
+		}
+		if t != nil {
+			return t.ToModel(customerID, userManager.instanceid, repoID, event.GetRepo().GetHTMLURL()), nil
+		}
+		release.Active = false
+	}
+	return release, nil
+}
+
+// fetchTag returns the tag with the name and its release, either of which are nil if they don't exist
+func (g *GithubIntegration) fetchTag(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, repo *github.Repository, name string) (*tag, *release, error) {
+	repoOwner, repoLogin := g.getRepoDetails(repo.GetFullName())
+	variables := map[string]interface{}{
+		"owner": repoOwner,
+		"name":  repoLogin,
+		"ref":   "refs/tags/" + name,
+		"tag":   name,
+	}
+	var result struct {
+		Repository struct {
+			Ref     *tag     `json:"ref"`
+			Release *release `json:"release"`
+		} `json:"repository"`
+	}
+	if err := g.newQueryExecutor(logger, client, control).Query(tagQuery, variables, &result); err != nil {
+		return nil, nil, fmt.Errorf("error fetching tag %s for %s: %w", name, repo.GetFullName(), err)
+	}
+	return result.Repository.Ref, result.Repository.Release, nil
+}
+
+// fromTagEvent returns the release for a tag which was created or deleted
+func (g *GithubIntegration) fromTagEvent(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, control sdk.Control, customerID string, repo *github.Repository, name string, deleted bool) (*sdk.SourceCodeRelease, error) {
+	repoID := sdk.NewSourceCodeRepoID(customerID, repo.GetNodeID(), refType)
+	if deleted {
+		release := newReleaseModel(customerID, userManager.instanceid, repoID, name, "")
+		release.Active = false
+		return release, nil
+	}
+	t, r, err := g.fetchTag(logger, client, control, repo, name)
+	if err != nil {
+		return nil, err
+	}
+	// the release for the tag has all the details so prefer it to the tag
+	if r != nil {
+		return r.ToModel(logger, userManager, customerID, repoID)
+	}
+	if t == nil {
+		sdk.LogInfo(logger, "tag not found", "name", name, "repo", repo.GetFullName())
+		return nil, nil
+	}
+	return t.ToModel(customerID, userManager.instanceid, repoID, repo.GetHTMLURL()), nil
+}
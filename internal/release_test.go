@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagShaAndDate(t *testing.T) {
+	assert := assert.New(t)
+	var lightweight tag
+	assert.NoError(json.Unmarshal([]byte(`{"name":"v1.0.0","target":{"type":"Commit","oid":"abc","committedDate":"2020-10-01T10:00:00Z"}}`), &lightweight))
+	assert.Equal("abc", lightweight.Sha())
+	assert.Equal(2020, lightweight.Date().Year())
+	assert.Equal(2020, lightweight.CommitDate().Year())
+
+	var annotated tag
+	assert.NoError(json.Unmarshal([]byte(`{"name":"v1.1.0","target":{"type":"Tag","oid":"def","tagger":{"date":"2019-05-01T10:00:00Z"},"target":{"oid":"123","committedDate":"2018-01-01T10:00:00Z"}}}`), &annotated))
+	assert.Equal("123", annotated.Sha())
+	assert.Equal(2019, annotated.Date().Year())
+	assert.Equal(2018, annotated.CommitDate().Year())
+}
+
+func TestFromReleaseEventDeleted(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	client := &mockPagedGraphQLClient{
+		responses: []string{
+			`{"repository":{"ref":{"name":"v1.0.0","target":{"type":"Commit","oid":"abc","committedDate":"2020-10-01T10:00:00Z"}},"release":null}}`,
+			`{"repository":{"ref":null,"release":null}}`,
+		},
+	}
+	event := &github.ReleaseEvent{
+		Action:  github.String("deleted"),
+		Release: &github.RepositoryRelease{TagName: github.String("v1.0.0"), Name: github.String("First")},
+		Repo:    &github.Repository{FullName: github.String("pinpt/agent"), NodeID: github.String("R_1"), HTMLURL: github.String("https://github.com/pinpt/agent")},
+	}
+	userManager := newMockUserManager(&mockPipe{})
+	release, err := g.fromReleaseEvent(sdk.NewNoOpTestLogger(), client, userManager, &mockControl{}, "1234", event)
+	assert.NoError(err)
+	assert.True(release.Active)
+	assert.Equal("v1.0.0", release.Name)
+	assert.Equal("abc", release.CommitSha)
+	assert.Equal("refs/tags/v1.0.0", client.variables[0]["ref"])
+
+	release, err = g.fromReleaseEvent(sdk.NewNoOpTestLogger(), client, userManager, &mockControl{}, "1234", event)
+	assert.NoError(err)
+	assert.False(release.Active)
+}
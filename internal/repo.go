@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/go-github/v32/github"
@@ -26,7 +27,7 @@ type repository struct {
 	} `json:"owner"`
 }
 
-func (g *GithubIntegration) fromRepositoryEvent(logger sdk.Logger, state sdk.State, integrationInstanceID string, customerID string, event *github.RepositoryEvent) (*sdk.SourceCodeRepo, *sdk.WorkProject, *sdk.WorkProjectCapability) {
+func (g *GithubIntegration) fromRepositoryEvent(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, state sdk.State, issueTypes issueTypeMapping, priorities issuePriorityMapping, integrationInstanceID string, customerID string, event *github.RepositoryEvent) (*sdk.SourceCodeRepo, *sdk.WorkProject, *sdk.WorkProjectCapability, error) {
 	var repo repository
 	theRepo := event.GetRepo()
 	login := getRepoOwnerLogin(theRepo)
@@ -49,10 +50,19 @@ func (g *GithubIntegration) fromRepositoryEvent(logger sdk.Logger, state sdk.Sta
 	repo.HasProjects = theRepo.GetHasProjects()
 	repo.Owner.Login = login
 	isPrivate := theRepo.GetPrivate()
-	return repo.ToModel(state, false, customerID, integrationInstanceID, login, isPrivate, scope)
+	if repo.HasIssues && event.GetAction() != "deleted" {
+		// the webhook doesn't have the labels which are needed for the issue types of the project
+		labels, err := g.fetchRepoLabels(logger, client, control, repo.Name, "")
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		repo.Labels.Nodes = labels
+	}
+	r, project, capability := repo.ToModel(state, false, issueTypes, priorities, customerID, integrationInstanceID, login, isPrivate, scope)
+	return r, project, capability, nil
 }
 
-func (r repository) ToModel(state sdk.State, historical bool, customerID string, integrationInstanceID string, login string, isPrivate bool, scope sdk.ConfigAccountType) (*sdk.SourceCodeRepo, *sdk.WorkProject, *sdk.WorkProjectCapability) {
+func (r repository) ToModel(state sdk.State, historical bool, issueTypes issueTypeMapping, priorities issuePriorityMapping, customerID string, integrationInstanceID string, login string, isPrivate bool, scope sdk.ConfigAccountType) (*sdk.SourceCodeRepo, *sdk.WorkProject, *sdk.WorkProjectCapability) {
 	repo := &sdk.SourceCodeRepo{}
 	repo.ID = sdk.NewSourceCodeRepoID(customerID, r.ID, refType)
 	repo.CustomerID = customerID
@@ -82,7 +92,7 @@ func (r repository) ToModel(state sdk.State, historical bool, customerID string,
 	}
 
 	// since a repo can also possibly be a work project, try and create it too
-	return repo, r.ToProjectModel(repo), r.ToProjectCapabilityModel(state, repo, historical)
+	return repo, r.ToProjectModel(repo, issueTypes), r.ToProjectCapabilityModel(state, repo, historical, issueTypes, priorities)
 }
 
 func getRepoOwnerLogin(repo *github.Repository) string {
@@ -98,3 +108,39 @@ func getPushRepoOwnerLogin(repo *github.PushEventRepository) string {
 	}
 	return repo.Owner.GetLogin()
 }
+
+// fetchRepoLabels returns the labels for the repo after the cursor
+func (g *GithubIntegration) fetchRepoLabels(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, repoName string, cursor string) ([]label, error) {
+	repoOwner, repoLogin := g.getRepoDetails(repoName)
+	variables := map[string]interface{}{
+		"first": 100,
+		"owner": repoOwner,
+		"name":  repoLogin,
+	}
+	if cursor != "" {
+		variables["after"] = cursor
+	}
+	exec := g.newQueryExecutor(logger, client, control)
+	labels := make([]label, 0)
+	for {
+		sdk.LogDebug(logger, "running fetch repo labels", "repo", repoName, "after", variables["after"])
+		var result struct {
+			RateLimit  rateLimit `json:"rateLimit"`
+			Repository struct {
+				Labels labelNode `json:"labels"`
+			} `json:"repository"`
+		}
+		if err := exec.Query(repoLabelsPagedQuery, variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching repo labels: %w", err)
+		}
+		labels = append(labels, result.Repository.Labels.Nodes...)
+		if !result.Repository.Labels.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return nil, err
+		}
+		variables["after"] = result.Repository.Labels.PageInfo.EndCursor
+	}
+	return labels, nil
+}
@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"strings"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+const issueResolutionCacheKeyPrefix = "issue_resolution_"
+
+type issueResolution struct {
+	RefID       string
+	Name        string
+	Description string
+}
+
+// issueResolutions are the resolutions of a closed issue
+var issueResolutions = []issueResolution{
+	{"completed", "Completed", "the issue was completed"},
+	{"not_planned", "Not Planned", "the issue was closed because it isn't planned"},
+	{"duplicate", "Duplicate", "the issue was closed as a duplicate"},
+	{"wontfix", "Won't Fix", "the issue was closed because it won't be worked on"},
+	{"invalid", "Invalid", "the issue was closed because it isn't valid"},
+}
+
+// issueResolutionStateReasons maps the state reason of a closed issue to a resolution
+var issueResolutionStateReasons = map[string]string{
+	"COMPLETED":   "completed",
+	"NOT_PLANNED": "not_planned",
+	"DUPLICATE":   "duplicate",
+}
+
+// issueResolutionLabels maps the labels which override the state reason to a resolution
+var issueResolutionLabels = map[string]string{
+	"duplicate": "duplicate",
+	"wontfix":   "wontfix",
+	"won't fix": "wontfix",
+	"invalid":   "invalid",
+}
+
+func findIssueResolution(refID string) *issueResolution {
+	for _, r := range issueResolutions {
+		if r.RefID == refID {
+			return &r
+		}
+	}
+	return nil
+}
+
+// processIssueResolutions will write out the resolutions
+func (g *GithubIntegration) processIssueResolutions(logger sdk.Logger, pipe sdk.Pipe, state sdk.State, customerID string, integrationInstanceID string, historical bool) error {
+	for _, r := range issueResolutions {
+		key := issueResolutionCacheKeyPrefix + r.RefID
+		if !historical && state.Exists(key) {
+			continue
+		}
+		var resolution sdk.WorkIssueResolution
+		resolution.CustomerID = customerID
+		resolution.IntegrationInstanceID = sdk.StringPointer(integrationInstanceID)
+		resolution.RefID = r.RefID
+		resolution.RefType = refType
+		resolution.Name = r.Name
+		resolution.Description = sdk.StringPointer(r.Description)
+		resolution.ID = sdk.NewWorkIssueResolutionID(customerID, refType, r.RefID)
+		if err := pipe.Write(&resolution); err != nil {
+			return err
+		}
+		sdk.LogDebug(logger, "writing issue resolution to state", "name", r.Name)
+		if err := state.Set(key, resolution.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setIssueResolution sets the resolution on a closed issue from its state reason, a resolution label overrides the
+// state reason since they are more specific than completed
+func setIssueResolution(issue *sdk.WorkIssue, closed bool, stateReason string, labels []label) {
+	if !closed {
+		return
+	}
+	refID := issueResolutionStateReasons[strings.ToUpper(stateReason)]
+	for _, l := range labels {
+		if r, ok := issueResolutionLabels[strings.ToLower(l.Name)]; ok {
+			refID = r
+			break
+		}
+	}
+	if resolution := findIssueResolution(refID); resolution != nil {
+		issue.Resolution = resolution.Name
+		issue.ResolutionID = sdk.NewWorkIssueResolutionID(issue.CustomerID, refType, resolution.RefID)
+	}
+}
@@ -0,0 +1,27 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetIssueResolution(t *testing.T) {
+	assert := assert.New(t)
+	var issue sdk.WorkIssue
+	setIssueResolution(&issue, true, "NOT_PLANNED", []label{{Name: "bug"}})
+	assert.Equal("Not Planned", issue.Resolution)
+
+	issue = sdk.WorkIssue{}
+	setIssueResolution(&issue, true, "COMPLETED", []label{{Name: "bug"}, {Name: "WontFix"}})
+	assert.Equal("Won't Fix", issue.Resolution)
+
+	issue = sdk.WorkIssue{}
+	setIssueResolution(&issue, true, "", nil)
+	assert.Empty(issue.Resolution)
+
+	issue = sdk.WorkIssue{}
+	setIssueResolution(&issue, false, "", []label{{Name: "duplicate"}})
+	assert.Empty(issue.Resolution)
+}
@@ -0,0 +1,305 @@
+package internal
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+// securityAlert is the union of the dependabot, code scanning and secret scanning alerts from the rest api and the
+// legacy repository vulnerability alert webhook
+// easyjson:skip
+type securityAlert struct {
+	Number          int64        `json:"number"`
+	ID              int64        `json:"id"`
+	State           string       `json:"state"`
+	HTMLURL         string       `json:"html_url"`
+	CreatedAt       *time.Time   `json:"created_at"`
+	UpdatedAt       *time.Time   `json:"updated_at"`
+	FixedAt         *time.Time   `json:"fixed_at"`
+	DismissedAt     *time.Time   `json:"dismissed_at"`
+	DismissedBy     *github.User `json:"dismissed_by"`
+	DismissedReason string       `json:"dismissed_reason"`
+	ResolvedAt      *time.Time   `json:"resolved_at"`
+	ResolvedBy      *github.User `json:"resolved_by"`
+	Resolution      string       `json:"resolution"`
+	// dependabot
+	Dependency *struct {
+		Package struct {
+			Ecosystem string `json:"ecosystem"`
+			Name      string `json:"name"`
+		} `json:"package"`
+	} `json:"dependency"`
+	SecurityAdvisory *struct {
+		GhsaID      string `json:"ghsa_id"`
+		Summary     string `json:"summary"`
+		Description string `json:"description"`
+		Severity    string `json:"severity"`
+	} `json:"security_advisory"`
+	// code scanning
+	Rule *struct {
+		ID                    string `json:"id"`
+		Name                  string `json:"name"`
+		Description           string `json:"description"`
+		Severity              string `json:"severity"`
+		SecuritySeverityLevel string `json:"security_severity_level"`
+	} `json:"rule"`
+	// secret scanning
+	SecretType            string `json:"secret_type"`
+	SecretTypeDisplayName string `json:"secret_type_display_name"`
+	// repository vulnerability alert
+	AffectedPackageName string       `json:"affected_package_name"`
+	ExternalIdentifier  string       `json:"external_identifier"`
+	Severity            string       `json:"severity"`
+	Dismisser           *github.User `json:"dismisser"`
+	DismissReason       string       `json:"dismiss_reason"`
+}
+
+// securityAlertEvent is the webhook for all the security alerts, none of which are supported by go-github yet
+// easyjson:skip
+type securityAlertEvent struct {
+	Action string             `json:"action"`
+	Alert  securityAlert      `json:"alert"`
+	Repo   *github.Repository `json:"repository"`
+}
+
+// securityAlertEndpoints are the rest endpoints for each type of security alert
+var securityAlertEndpoints = map[sdk.SourceCodeSecurityAlertType]string{
+	sdk.SourceCodeSecurityAlertTypeDependency:     "dependabot",
+	sdk.SourceCodeSecurityAlertTypeCodeScanning:   "code-scanning",
+	sdk.SourceCodeSecurityAlertTypeSecretScanning: "secret-scanning",
+}
+
+// securityAlertEventTypes are the types of security alert for each webhook
+var securityAlertEventTypes = map[string]sdk.SourceCodeSecurityAlertType{
+	"dependabot_alert":               sdk.SourceCodeSecurityAlertTypeDependency,
+	"repository_vulnerability_alert": sdk.SourceCodeSecurityAlertTypeDependency,
+	"code_scanning_alert":            sdk.SourceCodeSecurityAlertTypeCodeScanning,
+	"secret_scanning_alert":          sdk.SourceCodeSecurityAlertTypeSecretScanning,
+}
+
+func securityAlertState(state string) sdk.SourceCodeSecurityAlertState {
+	switch state {
+	case "fixed":
+		return sdk.SourceCodeSecurityAlertStateFixed
+	case "dismissed", "auto_dismissed":
+		return sdk.SourceCodeSecurityAlertStateDismissed
+	case "resolved":
+		return sdk.SourceCodeSecurityAlertStateResolved
+	}
+	return sdk.SourceCodeSecurityAlertStateOpen
+}
+
+func (a securityAlert) number() int64 {
+	if a.Number == 0 {
+		return a.ID
+	}
+	return a.Number
+}
+
+// refID returns the ref id of the alert. a legacy repository vulnerability alert has no number so it is keyed by its
+// id under its own prefix, which can't collide with the number of a dependabot alert
+func (a securityAlert) refID(alertType sdk.SourceCodeSecurityAlertType) string {
+	if a.Number == 0 {
+		return fmt.Sprintf("vulnerability/%d", a.ID)
+	}
+	return fmt.Sprintf("%s/%d", securityAlertEndpoints[alertType], a.Number)
+}
+
+// ToModel returns the alert model. the history is built from the dates the alert changed state since the api doesn't
+// return the full history of an alert
+func (a securityAlert) ToModel(logger sdk.Logger, userManager *UserManager, customerID string, repoID string, alertType sdk.SourceCodeSecurityAlertType) (*sdk.SourceCodeSecurityAlert, error) {
+	alert := &sdk.SourceCodeSecurityAlert{}
+	alert.CustomerID = customerID
+	alert.RefType = refType
+	alert.RefID = a.refID(alertType)
+	alert.RepoID = repoID
+	alert.ID = sdk.NewSourceCodeSecurityAlertID(customerID, alert.RefID, refType, repoID)
+	alert.IntegrationInstanceID = sdk.StringPointer(userManager.instanceid)
+	alert.Type = alertType
+	alert.Number = a.number()
+	alert.URL = a.HTMLURL
+	alert.State = securityAlertState(a.State)
+	alert.Active = true
+	alert.Severity = a.Severity
+	alert.Title = a.Title()
+	alert.Package = a.AffectedPackageName
+	alert.Identifier = a.ExternalIdentifier
+	if a.Dependency != nil {
+		alert.Package = a.Dependency.Package.Name
+		if a.Dependency.Package.Ecosystem != "" {
+			alert.Package = a.Dependency.Package.Ecosystem + ":" + a.Dependency.Package.Name
+		}
+	}
+	if a.SecurityAdvisory != nil {
+		alert.Identifier = a.SecurityAdvisory.GhsaID
+		alert.Title = a.SecurityAdvisory.Summary
+		alert.Description = a.SecurityAdvisory.Description
+		alert.Severity = a.SecurityAdvisory.Severity
+	}
+	if a.Rule != nil {
+		alert.Rule = a.Rule.ID
+		alert.Identifier = a.Rule.ID
+		alert.Title = a.Rule.Name
+		alert.Description = a.Rule.Description
+		alert.Severity = a.Rule.SecuritySeverityLevel
+		if alert.Severity == "" {
+			alert.Severity = a.Rule.Severity
+		}
+	}
+	if a.SecretType != "" {
+		alert.Rule = a.SecretType
+		alert.Identifier = a.SecretType
+		alert.Title = a.SecretTypeDisplayName
+	}
+	if a.CreatedAt != nil {
+		sdk.ConvertTimeToDateModel(*a.CreatedAt, &alert.CreatedDate)
+		var history sdk.SourceCodeSecurityAlertHistory
+		history.State = sdk.SourceCodeSecurityAlertStateOpen
+		sdk.ConvertTimeToDateModel(*a.CreatedAt, &history.CreatedDate)
+		alert.History = append(alert.History, history)
+	}
+	if a.UpdatedAt != nil {
+		sdk.ConvertTimeToDateModel(*a.UpdatedAt, &alert.UpdatedDate)
+	}
+	if a.FixedAt != nil {
+		sdk.ConvertTimeToDateModel(*a.FixedAt, &alert.FixedDate)
+		var history sdk.SourceCodeSecurityAlertHistory
+		history.State = sdk.SourceCodeSecurityAlertStateFixed
+		sdk.ConvertTimeToDateModel(*a.FixedAt, &history.CreatedDate)
+		alert.History = append(alert.History, history)
+	}
+	dismissedAt, dismissedBy, dismissedReason, dismissedState := a.DismissedAt, a.DismissedBy, a.DismissedReason, sdk.SourceCodeSecurityAlertStateDismissed
+	if dismissedBy == nil {
+		dismissedBy = a.Dismisser
+	}
+	if dismissedReason == "" {
+		dismissedReason = a.DismissReason
+	}
+	if a.ResolvedAt != nil {
+		// a secret scanning alert is resolved rather than dismissed
+		dismissedAt, dismissedBy, dismissedReason, dismissedState = a.ResolvedAt, a.ResolvedBy, a.Resolution, sdk.SourceCodeSecurityAlertStateResolved
+	}
+	if dismissedAt != nil {
+		sdk.ConvertTimeToDateModel(*dismissedAt, &alert.DismissedDate)
+		alert.DismissedReason = dismissedReason
+		var history sdk.SourceCodeSecurityAlertHistory
+		history.State = dismissedState
+		sdk.ConvertTimeToDateModel(*dismissedAt, &history.CreatedDate)
+		if dismissedBy != nil {
+			author := userToAuthor(dismissedBy)
+			alert.DismissedByRefID = author.RefID(customerID)
+			history.UserRefID = alert.DismissedByRefID
+			if err := userManager.emitAuthor(logger, author); err != nil {
+				return nil, err
+			}
+		}
+		alert.History = append(alert.History, history)
+	}
+	return alert, nil
+}
+
+// Title returns the title of a legacy repository vulnerability alert
+func (a securityAlert) Title() string {
+	if a.AffectedPackageName == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s vulnerability in %s", a.ExternalIdentifier, a.AffectedPackageName)
+}
+
+// checkSecurityEventsAccess returns true if the token can read the security alerts. an oauth token needs the repo or
+// security_events scope while other tokens don't return their scopes so we find out when fetching the alerts
+func (g *GithubIntegration) checkSecurityEventsAccess(logger sdk.Logger, httpclient sdk.HTTPClient) bool {
+	scopes, ok, err := tokenScopes(httpclient)
+	if err != nil {
+		sdk.LogWarn(logger, "error checking token scopes, assuming no security_events access", "err", err)
+		return false
+	}
+	if !ok {
+		return true
+	}
+	for _, scope := range scopes {
+		switch scope {
+		case "repo", "security_events":
+			return true
+		}
+	}
+	return false
+}
+
+func (g *GithubIntegration) getSecurityAlertsKey(repoName string) string {
+	return fmt.Sprintf("security_alerts_%s", repoName)
+}
+
+// exportSecurityAlerts will export the dependabot, code scanning and secret scanning alerts for the repo. in an
+// incremental only the alerts updated since the previous export are exported
+func (g *GithubIntegration) exportSecurityAlerts(logger sdk.Logger, httpclient sdk.HTTPClient, userManager *UserManager, errs *exportErrors, export sdk.Export, repoName string, repoID string, historical bool) error {
+	state := export.State()
+	pipe := export.Pipe()
+	started := time.Now()
+	var since time.Time
+	if !historical {
+		state.Get(g.getSecurityAlertsKey(repoName), &since)
+	}
+	for _, alertType := range []sdk.SourceCodeSecurityAlertType{sdk.SourceCodeSecurityAlertTypeDependency, sdk.SourceCodeSecurityAlertTypeCodeScanning, sdk.SourceCodeSecurityAlertTypeSecretScanning} {
+		endpoint := "/repos/" + repoName + "/" + securityAlertEndpoints[alertType] + "/alerts"
+		params := url.Values{}
+		params.Set("per_page", "100")
+		params.Set("sort", "updated")
+		params.Set("direction", "desc")
+		var count int
+		for params != nil {
+			sdk.LogDebug(logger, "running fetch security alerts", "name", repoName, "endpoint", endpoint, "params", params.Encode())
+			var alerts []securityAlert
+			resp, err := httpclient.Get(&alerts, sdk.WithEndpoint(endpoint), sdk.WithGetQueryParameters(params))
+			if err != nil {
+				if ok, status, _ := sdk.IsHTTPError(err); ok && (status == http.StatusNotFound || status == http.StatusForbidden) {
+					// the alerts aren't enabled for this repo
+					sdk.LogDebug(logger, "security alerts not enabled", "name", repoName, "endpoint", endpoint, "status", status)
+					break
+				}
+				return fmt.Errorf("error fetching %s alerts for %s: %w", securityAlertEndpoints[alertType], repoName, err)
+			}
+			params = pageLinkParams(resp, "next")
+			for _, a := range alerts {
+				if a.UpdatedAt != nil && a.UpdatedAt.Before(since) {
+					params = nil
+					break
+				}
+				alert, err := a.ToModel(logger, userManager, export.CustomerID(), repoID, alertType)
+				if err != nil {
+					errs.Add(repoName, "security alert", strconv.FormatInt(a.number(), 10), err)
+					continue
+				}
+				if err := pipe.Write(alert); err != nil {
+					return err
+				}
+				count++
+			}
+		}
+		sdk.LogDebug(logger, "fetched security alerts", "name", repoName, "endpoint", endpoint, "count", count)
+	}
+	return state.Set(g.getSecurityAlertsKey(repoName), started)
+}
+
+// fromSecurityAlertEvent returns the alert which was changed
+func (g *GithubIntegration) fromSecurityAlertEvent(logger sdk.Logger, userManager *UserManager, customerID string, alertType sdk.SourceCodeSecurityAlertType, event *securityAlertEvent) (*sdk.SourceCodeSecurityAlert, error) {
+	repoID := sdk.NewSourceCodeRepoID(customerID, event.Repo.GetNodeID(), refType)
+	alert, err := event.Alert.ToModel(logger, userManager, customerID, repoID, alertType)
+	if err != nil {
+		return nil, err
+	}
+	switch event.Action {
+	case "dismiss":
+		// the legacy repository vulnerability alert uses actions rather than the state of the alert
+		alert.State = sdk.SourceCodeSecurityAlertStateDismissed
+	case "resolve":
+		alert.State = sdk.SourceCodeSecurityAlertStateFixed
+	}
+	return alert, nil
+}
@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityAlertState(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(sdk.SourceCodeSecurityAlertStateOpen, securityAlertState("open"))
+	assert.Equal(sdk.SourceCodeSecurityAlertStateFixed, securityAlertState("fixed"))
+	assert.Equal(sdk.SourceCodeSecurityAlertStateDismissed, securityAlertState("auto_dismissed"))
+	assert.Equal(sdk.SourceCodeSecurityAlertStateResolved, securityAlertState("resolved"))
+}
+
+func TestSecurityAlertRefID(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("dependabot/12", securityAlert{ID: 98765, Number: 12}.refID(sdk.SourceCodeSecurityAlertTypeDependency))
+	assert.Equal("secret-scanning/3", securityAlert{Number: 3}.refID(sdk.SourceCodeSecurityAlertTypeSecretScanning))
+	// a legacy repository vulnerability alert has no number
+	assert.Equal("vulnerability/12", securityAlert{ID: 12}.refID(sdk.SourceCodeSecurityAlertTypeDependency))
+}
+
+func TestCheckSecurityEventsAccess(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	logger := sdk.NewNoOpTestLogger()
+	check := func(headers http.Header, err error) bool {
+		client := &MockHTTPClient{
+			Callback: func(method string, data io.Reader, out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+				return &sdk.HTTPResponse{StatusCode: http.StatusOK, Headers: headers}, err
+			},
+		}
+		return g.checkSecurityEventsAccess(logger, client)
+	}
+	assert.True(check(http.Header{"X-Oauth-Scopes": []string{"read:org, security_events"}}, nil))
+	assert.True(check(http.Header{"X-Oauth-Scopes": []string{"repo"}}, nil))
+	assert.False(check(http.Header{"X-Oauth-Scopes": []string{"public_repo"}}, nil))
+	assert.True(check(http.Header{}, nil))
+	assert.False(check(nil, errors.New("boom")))
+}
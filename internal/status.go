@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+type commitStatus struct {
+	Context     string    `json:"context"`
+	State       string    `json:"state"`
+	TargetURL   string    `json:"targetUrl"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type pullrequestHeadCommit struct {
+	Nodes []struct {
+		Commit struct {
+			Oid    string `json:"oid"`
+			Status *struct {
+				Contexts []commitStatus `json:"contexts"`
+			} `json:"status"`
+		} `json:"commit"`
+	} `json:"nodes"`
+}
+
+// commitStatusBuildStatus returns the build status for the commit status state and false if the status is still pending
+func commitStatusBuildStatus(state string) (sdk.CICDBuildStatus, bool) {
+	switch state {
+	case "success", "SUCCESS":
+		return sdk.CICDBuildStatusPass, true
+	case "failure", "FAILURE", "error", "ERROR":
+		return sdk.CICDBuildStatusFail, true
+	}
+	return sdk.CICDBuildStatusCancel, false
+}
+
+// commitStatusToModel returns the build for the commit status. the status api only keeps the latest status for each
+// context on a commit so the build is keyed by the sha and the context
+func commitStatusToModel(customerID string, integrationInstanceID string, repoName string, repoID string, sha string, branch string, status commitStatus) *sdk.CICDBuild {
+	buildStatus, completed := commitStatusBuildStatus(status.State)
+	if !completed {
+		return nil
+	}
+	build := newBuildModel(customerID, integrationInstanceID, repoName, repoID, fmt.Sprintf("%s:%s", sha, status.Context), sha)
+	build.Name = status.Context
+	build.Branch = branch
+	build.URL = status.TargetURL
+	build.Event = "status"
+	build.Status = buildStatus
+	setBuildDates(build, status.CreatedAt, time.Time{}, status.CreatedAt)
+	return build
+}
+
+// StatusBuilds returns the builds for the commit statuses on the head commit of the pull request
+func (c pullrequestHeadCommit) StatusBuilds(customerID string, integrationInstanceID string, repoName string, repoID string, branch string) []*sdk.CICDBuild {
+	builds := make([]*sdk.CICDBuild, 0)
+	for _, node := range c.Nodes {
+		if node.Commit.Status == nil {
+			continue
+		}
+		for _, status := range node.Commit.Status.Contexts {
+			if build := commitStatusToModel(customerID, integrationInstanceID, repoName, repoID, node.Commit.Oid, branch, status); build != nil {
+				builds = append(builds, build)
+			}
+		}
+	}
+	return builds
+}
+
+// fromStatusEvent returns the build for a commit status which was reported or nil if it is still pending
+func fromStatusEvent(customerID string, integrationInstanceID string, event *github.StatusEvent) *sdk.CICDBuild {
+	repoID := sdk.NewSourceCodeRepoID(customerID, event.GetRepo().GetNodeID(), refType)
+	var branch string
+	if len(event.Branches) > 0 {
+		branch = event.Branches[0].GetName()
+	}
+	status := commitStatus{
+		Context:     event.GetContext(),
+		State:       event.GetState(),
+		TargetURL:   event.GetTargetURL(),
+		Description: event.GetDescription(),
+		CreatedAt:   event.GetUpdatedAt().Time,
+	}
+	return commitStatusToModel(customerID, integrationInstanceID, event.GetRepo().GetFullName(), repoID, event.GetSHA(), branch, status)
+}
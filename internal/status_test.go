@@ -0,0 +1,21 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeadCommitStatusBuilds(t *testing.T) {
+	assert := assert.New(t)
+	var commit pullrequestHeadCommit
+	assert.NoError(json.Unmarshal([]byte(`{"nodes":[{"commit":{"oid":"abc","status":{"contexts":[{"context":"ci/jenkins","state":"ERROR","targetUrl":"https://jenkins/1","createdAt":"2020-10-01T10:00:00Z"},{"context":"ci/other","state":"PENDING","createdAt":"2020-10-01T10:00:00Z"}]}}}]}`), &commit))
+	builds := commit.StatusBuilds("1234", "5678", "pinpt/agent", "repo", "master")
+	assert.Len(builds, 1)
+	assert.Equal("abc:ci/jenkins", builds[0].RefID)
+	assert.Equal("ci/jenkins", builds[0].Name)
+	assert.Equal("master", builds[0].Branch)
+	assert.Equal(sdk.CICDBuildStatusFail, builds[0].Status)
+}
@@ -0,0 +1,331 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v32/github"
+	"github.com/pinpt/agent/v4/sdk"
+)
+
+type childTeam struct {
+	ID string `json:"id"`
+}
+
+type teamNode struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Description string    `json:"description"`
+	URL         string    `json:"url"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+	ParentTeam  *struct {
+		ID string `json:"id"`
+	} `json:"parentTeam"`
+	ChildTeams struct {
+		PageInfo pageInfo    `json:"pageInfo"`
+		Nodes    []childTeam `json:"nodes"`
+	} `json:"childTeams"`
+	Members struct {
+		PageInfo pageInfo `json:"pageInfo"`
+		Nodes    []author `json:"nodes"`
+	} `json:"members"`
+}
+
+func (t teamNode) ToModel(customerID string, integrationInstanceID string, members []author) *sdk.SourceCodeTeam {
+	team := &sdk.SourceCodeTeam{}
+	team.CustomerID = customerID
+	team.RefType = refType
+	team.RefID = t.ID
+	team.ID = sdk.NewSourceCodeTeamID(customerID, refType, t.ID)
+	team.IntegrationInstanceID = sdk.StringPointer(integrationInstanceID)
+	team.Name = t.Name
+	team.Description = t.Description
+	team.URL = t.URL
+	team.Active = true
+	team.UpdatedAt = sdk.TimeToEpoch(t.UpdatedAt)
+	if t.ParentTeam != nil {
+		team.ParentID = sdk.StringPointer(sdk.NewSourceCodeTeamID(customerID, refType, t.ParentTeam.ID))
+	}
+	team.ChildrenIds = make([]string, 0)
+	for _, child := range t.ChildTeams.Nodes {
+		team.ChildrenIds = append(team.ChildrenIds, sdk.NewSourceCodeTeamID(customerID, refType, child.ID))
+	}
+	team.MemberRefIds = make([]string, 0)
+	for _, member := range members {
+		if refID := member.RefID(customerID); refID != "" {
+			team.MemberRefIds = append(team.MemberRefIds, refID)
+		}
+	}
+	return team
+}
+
+// getExpandTeamReviewRequests returns true if a review request for a team should also be exported as a review request for each member of the team
+func (g *GithubIntegration) getExpandTeamReviewRequests(config sdk.Config) bool {
+	ok, val := config.GetBool("expand_team_review_requests")
+	if !ok {
+		ok, val = g.config.GetBool("expand_team_review_requests")
+	}
+	return ok && val
+}
+
+// emitTeam will write the team the first time it's seen so that it can be the requested reviewer on a
+// review request. if the team can't be fetched, such as when the token doesn't have the read:org scope,
+// only the details we already have are written
+func (u *UserManager) emitTeam(logger sdk.Logger, client sdk.GraphQLClient, id string, org string, slug string, name string, url string) error {
+	if id == "" {
+		return nil
+	}
+	u.mu.Lock()
+	if u.exportedTeams[id] {
+		u.mu.Unlock()
+		return nil
+	}
+	u.exportedTeams[id] = true
+	u.mu.Unlock()
+	found, err := u.integration.fetchTeam(logger, client, u, u.pipe, org, slug)
+	if err != nil {
+		if isFatalExportError(err) {
+			return err
+		}
+		sdk.LogWarn(logger, "error fetching team for review request, will only export the team name", "org", org, "slug", slug, "err", err)
+	} else if found {
+		return nil
+	}
+	node := teamNode{ID: id, Name: name, Slug: slug, URL: url, UpdatedAt: time.Now()}
+	return u.pipe.Write(node.ToModel(u.customerID, u.instanceid, nil))
+}
+
+// teamMembers returns all the members of the team including the members of child teams, only fetching them once for each team
+func (u *UserManager) teamMembers(logger sdk.Logger, client sdk.GraphQLClient, org string, slug string) ([]author, error) {
+	key := org + "/" + slug
+	u.mu.Lock()
+	members, ok := u.teams[key]
+	u.mu.Unlock()
+	if ok {
+		return members, nil
+	}
+	members, err := u.integration.fetchTeamMembers(logger, client, u.control, org, slug, "ALL", "")
+	if err != nil {
+		return nil, err
+	}
+	u.mu.Lock()
+	u.teams[key] = members
+	u.mu.Unlock()
+	return members, nil
+}
+
+// fetchTeamMembers will fetch the members of the team with the membership type (ALL, IMMEDIATE or CHILD_TEAM) starting after the cursor
+func (g *GithubIntegration) fetchTeamMembers(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, org string, slug string, membership string, cursor string) ([]author, error) {
+	key := org + "/" + slug
+	var variables = map[string]interface{}{
+		"first":      defaultPageSize,
+		"login":      org,
+		"slug":       slug,
+		"membership": membership,
+	}
+	if cursor != "" {
+		variables["after"] = cursor
+	}
+	exec := g.newQueryExecutor(logger, client, control)
+	members := make([]author, 0)
+	for {
+		var result struct {
+			RateLimit    rateLimit `json:"rateLimit"`
+			Organization struct {
+				Team struct {
+					Members struct {
+						PageInfo pageInfo `json:"pageInfo"`
+						Nodes    []author `json:"nodes"`
+					} `json:"members"`
+				} `json:"team"`
+			} `json:"organization"`
+		}
+		if err := exec.Query(teamMembersQuery, variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching members for team %s: %w", key, err)
+		}
+		members = append(members, result.Organization.Team.Members.Nodes...)
+		if !result.Organization.Team.Members.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return nil, err
+		}
+		variables["after"] = result.Organization.Team.Members.PageInfo.EndCursor
+	}
+	return members, nil
+}
+
+// fetchChildTeams will fetch the immediate child teams of the team starting after the cursor
+func (g *GithubIntegration) fetchChildTeams(logger sdk.Logger, client sdk.GraphQLClient, control sdk.Control, org string, slug string, cursor string) ([]childTeam, error) {
+	var variables = map[string]interface{}{
+		"first": defaultPageSize,
+		"login": org,
+		"slug":  slug,
+		"after": cursor,
+	}
+	exec := g.newQueryExecutor(logger, client, control)
+	children := make([]childTeam, 0)
+	for {
+		var result struct {
+			RateLimit    rateLimit `json:"rateLimit"`
+			Organization struct {
+				Team struct {
+					ChildTeams struct {
+						PageInfo pageInfo    `json:"pageInfo"`
+						Nodes    []childTeam `json:"nodes"`
+					} `json:"childTeams"`
+				} `json:"team"`
+			} `json:"organization"`
+		}
+		if err := exec.Query(teamChildTeamsQuery, variables, &result); err != nil {
+			return nil, fmt.Errorf("error fetching child teams for team %s/%s: %w", org, slug, err)
+		}
+		children = append(children, result.Organization.Team.ChildTeams.Nodes...)
+		if !result.Organization.Team.ChildTeams.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return nil, err
+		}
+		variables["after"] = result.Organization.Team.ChildTeams.PageInfo.EndCursor
+	}
+	return children, nil
+}
+
+// writeTeam will write the team and its members, fetching any members or child teams which weren't in the first page
+func (g *GithubIntegration) writeTeam(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, pipe sdk.Pipe, org string, node teamNode) error {
+	if node.ChildTeams.PageInfo.HasNextPage {
+		more, err := g.fetchChildTeams(logger, client, userManager.control, org, node.Slug, node.ChildTeams.PageInfo.EndCursor)
+		if err != nil {
+			return err
+		}
+		node.ChildTeams.Nodes = append(node.ChildTeams.Nodes, more...)
+	}
+	members := node.Members.Nodes
+	if node.Members.PageInfo.HasNextPage {
+		more, err := g.fetchTeamMembers(logger, client, userManager.control, org, node.Slug, "IMMEDIATE", node.Members.PageInfo.EndCursor)
+		if err != nil {
+			return err
+		}
+		members = append(members, more...)
+	}
+	for _, member := range members {
+		if err := userManager.emitAuthor(logger, member); err != nil {
+			return err
+		}
+	}
+	userManager.mu.Lock()
+	userManager.exportedTeams[node.ID] = true
+	userManager.mu.Unlock()
+	return pipe.Write(node.ToModel(userManager.customerID, userManager.instanceid, members))
+}
+
+// checkReadOrgAccess returns true if the token can read the teams. an oauth token needs one of the org scopes while
+// other tokens don't return their scopes so we find out when fetching the teams
+func (g *GithubIntegration) checkReadOrgAccess(logger sdk.Logger, httpclient sdk.HTTPClient) bool {
+	scopes, ok, err := tokenScopes(httpclient)
+	if err != nil {
+		sdk.LogWarn(logger, "error checking token scopes, assuming no read:org access", "err", err)
+		return false
+	}
+	if !ok {
+		return true
+	}
+	for _, scope := range scopes {
+		switch scope {
+		case "read:org", "write:org", "admin:org":
+			return true
+		}
+	}
+	return false
+}
+
+// exportTeams will export all the teams and their members for the org
+func (g *GithubIntegration) exportTeams(logger sdk.Logger, client sdk.GraphQLClient, export sdk.Export, userManager *UserManager, org string) (int, error) {
+	var variables = map[string]interface{}{
+		"first": defaultPageSize,
+		"login": org,
+	}
+	exec := g.newQueryExecutor(logger, client, export)
+	var count int
+	for {
+		sdk.LogDebug(logger, "running teams query", "org", org, "after", variables["after"])
+		var result struct {
+			RateLimit    rateLimit `json:"rateLimit"`
+			Organization struct {
+				Teams struct {
+					PageInfo pageInfo   `json:"pageInfo"`
+					Nodes    []teamNode `json:"nodes"`
+				} `json:"teams"`
+			} `json:"organization"`
+		}
+		if err := exec.Query(teamsQuery, variables, &result); err != nil {
+			return count, fmt.Errorf("error fetching teams for %s: %w", org, err)
+		}
+		for _, node := range result.Organization.Teams.Nodes {
+			if err := g.writeTeam(logger, client, userManager, export.Pipe(), org, node); err != nil {
+				return count, fmt.Errorf("error exporting team %s for %s: %w", node.Slug, org, err)
+			}
+			count++
+		}
+		if !result.Organization.Teams.PageInfo.HasNextPage {
+			break
+		}
+		if err := exec.checkRateLimit(result.RateLimit); err != nil {
+			return count, err
+		}
+		variables["after"] = result.Organization.Teams.PageInfo.EndCursor
+	}
+	return count, nil
+}
+
+// fetchTeam will fetch the team and write it and its members to the pipe. it returns false if the team isn't visible
+// to us, such as a secret team or when the token doesn't have the read:org scope
+func (g *GithubIntegration) fetchTeam(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, pipe sdk.Pipe, org string, slug string) (bool, error) {
+	variables := map[string]interface{}{
+		"login": org,
+		"slug":  slug,
+	}
+	var result struct {
+		Organization struct {
+			Team *teamNode `json:"team"`
+		} `json:"organization"`
+	}
+	if err := g.newQueryExecutor(logger, client, userManager.control).Query(teamQuery, variables, &result); err != nil {
+		return false, fmt.Errorf("error fetching team %s for %s: %w", slug, org, err)
+	}
+	if result.Organization.Team == nil {
+		sdk.LogInfo(logger, "team not found", "org", org, "slug", slug)
+		return false, nil
+	}
+	return true, g.writeTeam(logger, client, userManager, pipe, org, *result.Organization.Team)
+}
+
+// fromTeamEvent will update the team when it changes or deactivate it when it is deleted
+func (g *GithubIntegration) fromTeamEvent(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, pipe sdk.Pipe, event *github.TeamEvent) error {
+	org := event.GetOrg().GetLogin()
+	if event.GetAction() == "deleted" {
+		node := teamNode{
+			ID:          event.GetTeam().GetNodeID(),
+			Name:        event.GetTeam().GetName(),
+			Slug:        event.GetTeam().GetSlug(),
+			Description: event.GetTeam().GetDescription(),
+			UpdatedAt:   time.Now(),
+		}
+		team := node.ToModel(userManager.customerID, userManager.instanceid, nil)
+		team.Active = false
+		return pipe.Write(team)
+	}
+	_, err := g.fetchTeam(logger, client, userManager, pipe, org, event.GetTeam().GetSlug())
+	return err
+}
+
+// fromMembershipEvent will update the members of the team when a member is added or removed
+func (g *GithubIntegration) fromMembershipEvent(logger sdk.Logger, client sdk.GraphQLClient, userManager *UserManager, pipe sdk.Pipe, event *github.MembershipEvent) error {
+	if event.GetScope() != "team" {
+		return nil
+	}
+	_, err := g.fetchTeam(logger, client, userManager, pipe, event.GetOrg().GetLogin(), event.GetTeam().GetSlug())
+	return err
+}
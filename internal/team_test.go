@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamToModel(t *testing.T) {
+	assert := assert.New(t)
+	var node teamNode
+	assert.NoError(json.Unmarshal([]byte(`{"id":"T1","name":"Core","slug":"core","parentTeam":{"id":"T0"},"childTeams":{"nodes":[{"id":"T2"}]},"members":{"nodes":[{"type":"User","id":"U1","login":"jane"},{"type":"Bot","id":"B1","login":"ci"}]}}`), &node))
+	team := node.ToModel("1234", "5678", node.Members.Nodes)
+	assert.Equal("T1", team.RefID)
+	assert.Equal("Core", team.Name)
+	assert.True(team.Active)
+	assert.NotNil(team.ParentID)
+	assert.Len(team.ChildrenIds, 1)
+	assert.Equal([]string{"U1"}, team.MemberRefIds)
+}
+
+func TestEmitTeamWithoutAccess(t *testing.T) {
+	assert := assert.New(t)
+	pipe := &mockPipe{}
+	userManager := newMockUserManager(pipe)
+	client := &mockPagedGraphQLClient{}
+	assert.NoError(userManager.emitTeam(sdk.NewNoOpTestLogger(), client, "T1", "pinpt", "core", "Core", "https://github.com/orgs/pinpt/teams/core"))
+	assert.NoError(userManager.emitTeam(sdk.NewNoOpTestLogger(), client, "T1", "pinpt", "core", "Core", "https://github.com/orgs/pinpt/teams/core"))
+	assert.Len(client.variables, 1)
+	assert.Len(pipe.records, 1)
+	team, ok := pipe.records[0].(*sdk.SourceCodeTeam)
+	assert.True(ok)
+	assert.Equal("T1", team.RefID)
+	assert.Equal("Core", team.Name)
+	assert.Empty(team.MemberRefIds)
+}
+
+func TestEmitTeamNotFound(t *testing.T) {
+	assert := assert.New(t)
+	pipe := &mockPipe{}
+	userManager := newMockUserManager(pipe)
+	client := &mockPagedGraphQLClient{responses: []string{`{"organization":{"team":null}}`}}
+	assert.NoError(userManager.emitTeam(sdk.NewNoOpTestLogger(), client, "T1", "pinpt", "secret", "Secret", "https://github.com/orgs/pinpt/teams/secret"))
+	assert.Len(client.variables, 1)
+	assert.Len(pipe.records, 1)
+	team, ok := pipe.records[0].(*sdk.SourceCodeTeam)
+	assert.True(ok)
+	assert.Equal("T1", team.RefID)
+	assert.Equal("Secret", team.Name)
+}
+
+func TestWriteTeamChildTeams(t *testing.T) {
+	assert := assert.New(t)
+	var node teamNode
+	assert.NoError(json.Unmarshal([]byte(`{"id":"T1","name":"Core","slug":"core","childTeams":{"pageInfo":{"hasNextPage":true,"endCursor":"c1"},"nodes":[{"id":"T2"}]}}`), &node))
+	client := &mockPagedGraphQLClient{
+		responses: []string{
+			`{"rateLimit":{"limit":5000,"remaining":4999},"organization":{"team":{"childTeams":{"pageInfo":{"hasNextPage":true,"endCursor":"c2"},"nodes":[{"id":"T3"}]}}}}`,
+			`{"rateLimit":{"limit":5000,"remaining":4998},"organization":{"team":{"childTeams":{"pageInfo":{"hasNextPage":false},"nodes":[{"id":"T4"}]}}}}`,
+		},
+	}
+	pipe := &mockPipe{}
+	g := &GithubIntegration{}
+	assert.NoError(g.writeTeam(sdk.NewNoOpTestLogger(), client, newMockUserManager(pipe), pipe, "pinpt", node))
+	assert.Len(client.variables, 2)
+	assert.Equal("c1", client.variables[0]["after"])
+	assert.Equal("c2", client.variables[1]["after"])
+	assert.Len(pipe.records, 1)
+	team := pipe.records[0].(*sdk.SourceCodeTeam)
+	assert.Len(team.ChildrenIds, 3)
+}
+
+func TestCheckReadOrgAccess(t *testing.T) {
+	assert := assert.New(t)
+	g := &GithubIntegration{}
+	logger := sdk.NewNoOpTestLogger()
+	check := func(headers http.Header, err error) bool {
+		client := &MockHTTPClient{
+			Callback: func(method string, data io.Reader, out interface{}, options ...sdk.WithHTTPOption) (*sdk.HTTPResponse, error) {
+				return &sdk.HTTPResponse{StatusCode: http.StatusOK, Headers: headers}, err
+			},
+		}
+		return g.checkReadOrgAccess(logger, client)
+	}
+	assert.True(check(http.Header{"X-Oauth-Scopes": []string{"repo, read:org"}}, nil))
+	assert.True(check(http.Header{"X-Oauth-Scopes": []string{"admin:org"}}, nil))
+	assert.False(check(http.Header{"X-Oauth-Scopes": []string{"repo, user"}}, nil))
+	assert.True(check(http.Header{}, nil))
+	assert.False(check(nil, errors.New("boom")))
+}
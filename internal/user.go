@@ -10,16 +10,19 @@ import (
 // UserManager is a manager for users
 // easyjson:skip
 type UserManager struct {
-	customerID  string
-	orgs        []string
-	users       map[string]bool
-	control     sdk.Control
-	pipe        sdk.Pipe
-	integration *GithubIntegration
-	mu          sync.Mutex
-	instanceid  string
-	state       sdk.State
-	historical  bool
+	customerID    string
+	orgs          []string
+	users         map[string]bool
+	control       sdk.Control
+	pipe          sdk.Pipe
+	integration   *GithubIntegration
+	mu            sync.Mutex
+	instanceid    string
+	state         sdk.State
+	historical    bool
+	expandTeams   bool
+	teams         map[string][]author
+	exportedTeams map[string]bool
 }
 
 const userStatecacheKey = "user_"
@@ -102,14 +105,16 @@ func (u *UserManager) emitGitUser(logger sdk.Logger, author gitUser) error {
 // NewUserManager returns a new instance
 func NewUserManager(customerID string, orgs []string, control sdk.Control, state sdk.State, pipe sdk.Pipe, integration *GithubIntegration, instanceid string, historical bool) *UserManager {
 	return &UserManager{
-		customerID:  customerID,
-		orgs:        orgs,
-		users:       make(map[string]bool),
-		control:     control,
-		pipe:        pipe,
-		state:       state,
-		integration: integration,
-		instanceid:  instanceid,
-		historical:  historical,
+		customerID:    customerID,
+		orgs:          orgs,
+		users:         make(map[string]bool),
+		teams:         make(map[string][]author),
+		exportedTeams: make(map[string]bool),
+		control:       control,
+		pipe:          pipe,
+		state:         state,
+		integration:   integration,
+		instanceid:    instanceid,
+		historical:    historical,
 	}
 }
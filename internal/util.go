@@ -1,7 +1,92 @@
 package internal
 
-import "github.com/pinpt/agent/v4/sdk"
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/pinpt/agent/v4/sdk"
+)
 
 func toHTML(markdown string) string {
 	return `<div class="source-github">` + sdk.ConvertMarkdownToHTML(markdown) + `</div>`
 }
+
+// runParallel will call fn for each index up to count using concurrency goroutines, returning the first error.
+// once an error is returned no more work is started.
+func runParallel(concurrency int, count int, fn func(i int) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	var wg sync.WaitGroup
+	var once sync.Once
+	var failed error
+	work := make(chan int)
+	done := make(chan struct{})
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				if err := fn(i); err != nil {
+					once.Do(func() {
+						failed = err
+						close(done)
+					})
+					return
+				}
+			}
+		}()
+	}
+feed:
+	for i := 0; i < count; i++ {
+		select {
+		case work <- i:
+		case <-done:
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+	return failed
+}
+
+// pageLinkParams returns the query parameters for the page with the relation (next, prev, last) from the link header
+// or nil if there isn't one
+func pageLinkParams(resp *sdk.HTTPResponse, rel string) url.Values {
+	if resp == nil {
+		return nil
+	}
+	for _, link := range strings.Split(resp.Headers.Get("Link"), ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 || strings.TrimSpace(parts[1]) != `rel="`+rel+`"` {
+			continue
+		}
+		u, err := url.Parse(strings.Trim(strings.TrimSpace(parts[0]), "<>"))
+		if err != nil {
+			return nil
+		}
+		return u.Query()
+	}
+	return nil
+}
+
+// tokenScopes returns the scopes of an oauth token. other tokens, such as the token for a github app, don't return
+// their scopes so ok is false for them
+func tokenScopes(httpclient sdk.HTTPClient) (scopes []string, ok bool, err error) {
+	var kv map[string]interface{}
+	resp, err := httpclient.Get(&kv, sdk.WithEndpoint("/rate_limit"))
+	if err != nil {
+		return nil, false, fmt.Errorf("error checking token scopes: %w", err)
+	}
+	if _, ok := resp.Headers["X-Oauth-Scopes"]; !ok {
+		return nil, false, nil
+	}
+	for _, scope := range strings.Split(resp.Headers.Get("X-Oauth-Scopes"), ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, true, nil
+}
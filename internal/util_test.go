@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pinpt/agent/v4/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunParallel(t *testing.T) {
+	assert := assert.New(t)
+	var lock sync.Mutex
+	found := make(map[int]bool)
+	assert.NoError(runParallel(3, 10, func(i int) error {
+		lock.Lock()
+		found[i] = true
+		lock.Unlock()
+		return nil
+	}))
+	assert.Len(found, 10)
+}
+
+func TestRunParallelError(t *testing.T) {
+	assert := assert.New(t)
+	var lock sync.Mutex
+	var count int
+	err := runParallel(2, 100, func(i int) error {
+		lock.Lock()
+		count++
+		lock.Unlock()
+		if i == 3 {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	assert.EqualError(err, "boom")
+	assert.True(count < 100)
+}
+
+func TestSortReposByUpdated(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	repos := []repository{
+		{Name: "a", UpdatedAt: now.Add(-time.Hour)},
+		{Name: "b", UpdatedAt: now},
+		{Name: "c", UpdatedAt: now.Add(-time.Minute)},
+	}
+	sortReposByUpdated(repos)
+	assert.Equal("b", repos[0].Name)
+	assert.Equal("c", repos[1].Name)
+	assert.Equal("a", repos[2].Name)
+}
+
+func TestPageLinkParams(t *testing.T) {
+	assert := assert.New(t)
+	resp := &sdk.HTTPResponse{Headers: http.Header{}}
+	assert.Nil(pageLinkParams(resp, "next"))
+	resp.Headers.Set("Link", `<https://api.github.com/repos/pinpt/agent/dependabot/alerts?per_page=100&after=abc>; rel="next", <https://api.github.com/repos/pinpt/agent/dependabot/alerts?per_page=100>; rel="first"`)
+	params := pageLinkParams(resp, "next")
+	assert.Equal("abc", params.Get("after"))
+	assert.Equal("100", params.Get("per_page"))
+	assert.Nil(pageLinkParams(resp, "last"))
+}
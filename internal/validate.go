@@ -69,8 +69,13 @@ func (g *GithubIntegration) Validate(validate sdk.Validate) (map[string]interfac
 		return nil, fmt.Errorf("error fetching viewer accounts: %w", err)
 	}
 	accounts = append(accounts, account)
+	_, httpclient, err := g.newHTTPClient(logger, validate.Config())
+	if err != nil {
+		return nil, fmt.Errorf("error creating http client: %w", err)
+	}
 	res := map[string]interface{}{
-		"accounts": accounts,
+		"accounts":        accounts,
+		"security_events": g.checkSecurityEventsAccess(logger, httpclient),
 	}
 	return res, nil
 }
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -30,11 +31,32 @@ var webhookEvents = []string{
 	"project",
 	"pull_request_review",
 	"pull_request_review_comment",
+	"pull_request_review_thread",
 	"repository",
 	"milestone",
+	"create",
+	"delete",
+	"release",
+	"deployment",
+	"deployment_status",
+	"workflow_run",
+	"workflow_job",
+	"check_suite",
+	"check_run",
+	"status",
+	"dependabot_alert",
+	"code_scanning_alert",
+	"secret_scanning_alert",
+	"repository_vulnerability_alert",
 }
 
-const hookVersion = "1" // change this to upgrade the hook in case the events change
+// orgWebhookEvents are the events which are only available to an org webhook
+var orgWebhookEvents = []string{
+	"team",
+	"membership",
+}
+
+const hookVersion = "9" // change this to upgrade the hook in case the events change
 
 func (g *GithubIntegration) isOrgWebHookInstalled(manager sdk.WebHookManager, customerID string, integrationInstanceID string, login string) bool {
 	if manager.Exists(customerID, integrationInstanceID, refType, login, sdk.WebHookScopeOrg) {
@@ -259,7 +281,7 @@ func (g *GithubIntegration) registerOrgWebhook(logger sdk.Logger, manager sdk.We
 				"insecure_ssl": "0",
 				"secret":       integrationInstanceID,
 			},
-			"events": webhookEvents,
+			"events": append(webhookEvents, orgWebhookEvents...),
 			"active": true,
 		}
 		kv := make(map[string]interface{})
@@ -282,6 +304,38 @@ func (g *GithubIntegration) registerOrgWebhook(logger sdk.Logger, manager sdk.We
 	return nil
 }
 
+// newWebhookHTTPClient returns an http client for the webhook which shares the rate limit budget for the instance
+func (g *GithubIntegration) newWebhookHTTPClient(logger sdk.Logger, webhook sdk.WebHook) (sdk.HTTPClient, error) {
+	_, httpclient, err := g.newHTTPClient(logger, webhook.Config())
+	if err != nil {
+		return nil, err
+	}
+	return g.getRateLimitBudget(webhook.IntegrationInstanceID()).httpClient(logger, webhook, httpclient), nil
+}
+
+// parseWebHook parses the webhooks which go-github doesn't support before falling back to go-github
+func parseWebHook(event string, buf []byte) (interface{}, error) {
+	var obj interface{}
+	switch event {
+	case "workflow_run":
+		obj = &workflowRunEvent{}
+	case "workflow_job":
+		obj = &workflowJobEvent{}
+	case "dependabot_alert", "code_scanning_alert", "secret_scanning_alert", "repository_vulnerability_alert":
+		obj = &securityAlertEvent{}
+	case "pull_request_review_thread":
+		obj = &pullRequestReviewThreadEvent{}
+	case "commit_comment":
+		obj = &commitCommentEvent{}
+	default:
+		return github.ParseWebHook(event, buf)
+	}
+	if err := json.Unmarshal(buf, obj); err != nil {
+		return nil, fmt.Errorf("error parsing %s webhook: %w", event, err)
+	}
+	return obj, nil
+}
+
 // WebHook is called when a webhook is received on behalf of the integration
 func (g *GithubIntegration) WebHook(webhook sdk.WebHook) error {
 	logger := webhook.Logger()
@@ -293,7 +347,7 @@ func (g *GithubIntegration) WebHook(webhook sdk.WebHook) error {
 		sdk.LogWarn(logger, "webhook signature was invalid, not loading", "signature", sig, "err", err)
 		return nil
 	}
-	obj, err := github.ParseWebHook(event, buf)
+	obj, err := parseWebHook(event, buf)
 	if err != nil {
 		return err
 	}
@@ -303,7 +357,7 @@ func (g *GithubIntegration) WebHook(webhook sdk.WebHook) error {
 		if err != nil {
 			return err
 		}
-		client = cl
+		client = g.getRateLimitBudget(webhook.IntegrationInstanceID()).graphQLClient(logger, webhook, cl)
 	}
 	var objects []sdk.Model
 	switch v := obj.(type) {
@@ -314,6 +368,13 @@ func (g *GithubIntegration) WebHook(webhook sdk.WebHook) error {
 		if err != nil {
 			return err
 		}
+		httpclient, err := g.newWebhookHTTPClient(logger, webhook)
+		if err != nil {
+			return err
+		}
+		if err := g.fromPushBranchEvent(logger, client, httpclient, webhook, webhook.State(), webhook.Pipe(), webhook.CustomerID(), webhook.IntegrationInstanceID(), v); err != nil {
+			return err
+		}
 		for _, commit := range commits {
 			objects = append(objects, commit)
 		}
@@ -321,12 +382,13 @@ func (g *GithubIntegration) WebHook(webhook sdk.WebHook) error {
 		repoLogin := getRepoOwnerLogin(v.Repo)
 		userManager := NewUserManager(webhook.CustomerID(), []string{repoLogin}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
 		if v.Action != nil && (*v.Action == "review_requested" || *v.Action == "review_request_removed") {
-			obj, err := g.fromPullRequestReviewRequestedEvent(logger, client, userManager, webhook, webhook.CustomerID(), v)
+			userManager.expandTeams = g.getExpandTeamReviewRequests(webhook.Config())
+			reviewRequests, err := g.fromPullRequestReviewRequestedEvent(logger, client, userManager, webhook, webhook.CustomerID(), v)
 			if err != nil {
 				return err
 			}
-			if obj != nil {
-				objects = []sdk.Model{obj}
+			for _, reviewRequest := range reviewRequests {
+				objects = append(objects, reviewRequest)
 			}
 		} else {
 			obj, err := g.fromPullRequestEvent(logger, client, userManager, webhook, webhook.CustomerID(), v)
@@ -347,11 +409,31 @@ func (g *GithubIntegration) WebHook(webhook sdk.WebHook) error {
 		if obj != nil {
 			objects = []sdk.Model{obj}
 		}
-	case *github.CommitCommentEvent:
-		// NOTE: we don't really have commit comments in the model right now
+	case *commitCommentEvent:
+		userManager := NewUserManager(webhook.CustomerID(), []string{getRepoOwnerLogin(v.Repo)}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
+		comment, err := g.fromCommitCommentEvent(logger, userManager, webhook.CustomerID(), v)
+		if err != nil {
+			return err
+		}
+		objects = []sdk.Model{comment}
 	case *github.PullRequestReviewCommentEvent:
-		// NOTE: we don't really have pull request review comments in the model right now
-		break
+		userManager := NewUserManager(webhook.CustomerID(), []string{getRepoOwnerLogin(v.Repo)}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
+		comment, err := g.fromPullRequestReviewCommentEvent(logger, client, userManager, webhook, webhook.CustomerID(), v)
+		if err != nil {
+			return err
+		}
+		if comment != nil {
+			objects = []sdk.Model{comment}
+		}
+	case *pullRequestReviewThreadEvent:
+		userManager := NewUserManager(webhook.CustomerID(), []string{getRepoOwnerLogin(v.Repo)}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
+		comments, err := g.fromPullRequestReviewThreadEvent(logger, client, userManager, webhook, webhook.CustomerID(), v)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			objects = append(objects, comment)
+		}
 	case *github.IssueCommentEvent:
 		repoLogin := getRepoOwnerLogin(v.Repo)
 		if isIssueCommentPR(v) {
@@ -374,7 +456,15 @@ func (g *GithubIntegration) WebHook(webhook sdk.WebHook) error {
 			}
 		}
 	case *github.RepositoryEvent:
-		repo, project, capability := g.fromRepositoryEvent(logger, webhook.State(), webhook.IntegrationInstanceID(), webhook.CustomerID(), v)
+		issueTypes, err := g.getIssueTypeMapping(webhook.Config())
+		if err != nil {
+			return err
+		}
+		priorities, err := g.getIssuePriorityMapping(webhook.Config())
+		if err != nil {
+			return err
+		}
+		repo, project, capability, err := g.fromRepositoryEvent(logger, client, webhook, webhook.State(), issueTypes, priorities, webhook.IntegrationInstanceID(), webhook.CustomerID(), v)
 		if err != nil {
 			return err
 		}
@@ -390,7 +480,15 @@ func (g *GithubIntegration) WebHook(webhook sdk.WebHook) error {
 	case *github.IssuesEvent:
 		repoLogin := getRepoOwnerLogin(v.Repo)
 		userManager := NewUserManager(webhook.CustomerID(), []string{repoLogin}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
-		issue, err := g.fromIssueEvent(logger, userManager, webhook.IntegrationInstanceID(), webhook.CustomerID(), v)
+		issueTypes, err := g.getIssueTypeMapping(webhook.Config())
+		if err != nil {
+			return err
+		}
+		priorities, err := g.getIssuePriorityMapping(webhook.Config())
+		if err != nil {
+			return err
+		}
+		issue, err := g.fromIssueEvent(logger, client, userManager, webhook, issueTypes, priorities, webhook.IntegrationInstanceID(), webhook.CustomerID(), v)
 		if err != nil {
 			return err
 		}
@@ -407,6 +505,150 @@ func (g *GithubIntegration) WebHook(webhook sdk.WebHook) error {
 			return fmt.Errorf("error getting project id: %w", err)
 		}
 		return g.fetchRepoProject(logger, client, webhook.Pipe(), webhook, webhook.CustomerID(), webhook.IntegrationInstanceID(), v.Repo.GetFullName(), v.Repo.GetNodeID(), num)
+	case *github.CreateEvent:
+		switch v.GetRefType() {
+		case "branch":
+			httpclient, err := g.newWebhookHTTPClient(logger, webhook)
+			if err != nil {
+				return err
+			}
+			return g.updateBranch(logger, client, httpclient, webhook, webhook.State(), webhook.Pipe(), webhook.CustomerID(), webhook.IntegrationInstanceID(), v.Repo, v.GetRef())
+		case "tag":
+			userManager := NewUserManager(webhook.CustomerID(), []string{getRepoOwnerLogin(v.Repo)}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
+			release, err := g.fromTagEvent(logger, client, userManager, webhook, webhook.CustomerID(), v.Repo, v.GetRef(), false)
+			if err != nil {
+				return err
+			}
+			if release != nil {
+				objects = []sdk.Model{release}
+			}
+		}
+	case *github.DeleteEvent:
+		switch v.GetRefType() {
+		case "branch":
+			httpclient, err := g.newWebhookHTTPClient(logger, webhook)
+			if err != nil {
+				return err
+			}
+			return g.updateBranch(logger, client, httpclient, webhook, webhook.State(), webhook.Pipe(), webhook.CustomerID(), webhook.IntegrationInstanceID(), v.Repo, v.GetRef())
+		case "tag":
+			userManager := NewUserManager(webhook.CustomerID(), []string{getRepoOwnerLogin(v.Repo)}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
+			release, err := g.fromTagEvent(logger, client, userManager, webhook, webhook.CustomerID(), v.Repo, v.GetRef(), true)
+			if err != nil {
+				return err
+			}
+			objects = []sdk.Model{release}
+		}
+	case *github.ReleaseEvent:
+		userManager := NewUserManager(webhook.CustomerID(), []string{getRepoOwnerLogin(v.Repo)}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
+		release, err := g.fromReleaseEvent(logger, client, userManager, webhook, webhook.CustomerID(), v)
+		if err != nil {
+			return err
+		}
+		objects = []sdk.Model{release}
+	case *github.DeploymentEvent:
+		httpclient, err := g.newWebhookHTTPClient(logger, webhook)
+		if err != nil {
+			return err
+		}
+		userManager := NewUserManager(webhook.CustomerID(), []string{getRepoOwnerLogin(v.Repo)}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
+		deployment, err := g.fetchDeployment(logger, client, httpclient, userManager, webhook, webhook.CustomerID(), v.Repo.GetFullName(), v.Repo.GetNodeID(), v.Deployment.GetNodeID(), v.Deployment.GetEnvironment())
+		if err != nil {
+			return err
+		}
+		if deployment != nil {
+			objects = []sdk.Model{deployment}
+		}
+	case *github.DeploymentStatusEvent:
+		httpclient, err := g.newWebhookHTTPClient(logger, webhook)
+		if err != nil {
+			return err
+		}
+		userManager := NewUserManager(webhook.CustomerID(), []string{getRepoOwnerLogin(v.Repo)}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
+		deployment, err := g.fetchDeployment(logger, client, httpclient, userManager, webhook, webhook.CustomerID(), v.Repo.GetFullName(), v.Repo.GetNodeID(), v.Deployment.GetNodeID(), v.Deployment.GetEnvironment())
+		if err != nil {
+			return err
+		}
+		if deployment != nil {
+			objects = []sdk.Model{deployment}
+		}
+	case *workflowRunEvent:
+		if v.GetAction() != "completed" {
+			return nil
+		}
+		httpclient, err := g.newWebhookHTTPClient(logger, webhook)
+		if err != nil {
+			return err
+		}
+		jobs, err := g.fetchWorkflowJobs(httpclient, v.Repo.GetFullName(), v.WorkflowRun.GetID())
+		if err != nil {
+			return err
+		}
+		repoID := sdk.NewSourceCodeRepoID(webhook.CustomerID(), v.Repo.GetNodeID(), refType)
+		objects = []sdk.Model{workflowRunToModel(webhook.CustomerID(), webhook.IntegrationInstanceID(), v.Repo.GetFullName(), repoID, v.WorkflowRun, jobs)}
+	case *workflowJobEvent:
+		if v.GetAction() != "completed" {
+			return nil
+		}
+		httpclient, err := g.newWebhookHTTPClient(logger, webhook)
+		if err != nil {
+			return err
+		}
+		build, err := g.fetchWorkflowRunBuild(httpclient, webhook.CustomerID(), webhook.IntegrationInstanceID(), v.Repo, v.WorkflowJob.GetRunID())
+		if err != nil {
+			return err
+		}
+		if build != nil {
+			objects = []sdk.Model{build}
+		}
+	case *github.CheckSuiteEvent:
+		if v.GetAction() != "completed" {
+			return nil
+		}
+		httpclient, err := g.newWebhookHTTPClient(logger, webhook)
+		if err != nil {
+			return err
+		}
+		build, err := g.fetchCheckSuiteBuild(httpclient, webhook.CustomerID(), webhook.IntegrationInstanceID(), v.Repo, v.CheckSuite.GetID())
+		if err != nil {
+			return err
+		}
+		if build != nil {
+			objects = []sdk.Model{build}
+		}
+	case *github.CheckRunEvent:
+		if v.GetAction() != "completed" || v.CheckRun.GetCheckSuite().GetStatus() != "completed" {
+			// only build the suite from the last run to finish rather than refetching it for every run
+			return nil
+		}
+		httpclient, err := g.newWebhookHTTPClient(logger, webhook)
+		if err != nil {
+			return err
+		}
+		build, err := g.fetchCheckSuiteBuild(httpclient, webhook.CustomerID(), webhook.IntegrationInstanceID(), v.Repo, v.CheckRun.GetCheckSuite().GetID())
+		if err != nil {
+			return err
+		}
+		if build != nil {
+			objects = []sdk.Model{build}
+		}
+	case *github.StatusEvent:
+		if build := fromStatusEvent(webhook.CustomerID(), webhook.IntegrationInstanceID(), v); build != nil {
+			objects = []sdk.Model{build}
+		}
+	case *securityAlertEvent:
+		userManager := NewUserManager(webhook.CustomerID(), []string{getRepoOwnerLogin(v.Repo)}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
+		alert, err := g.fromSecurityAlertEvent(logger, userManager, webhook.CustomerID(), securityAlertEventTypes[event], v)
+		if err != nil {
+			return err
+		}
+		objects = []sdk.Model{alert}
+	case *github.TeamEvent:
+		userManager := NewUserManager(webhook.CustomerID(), []string{v.GetOrg().GetLogin()}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
+		return g.fromTeamEvent(logger, client, userManager, webhook.Pipe(), v)
+	case *github.MembershipEvent:
+		userManager := NewUserManager(webhook.CustomerID(), []string{v.GetOrg().GetLogin()}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
+		return g.fromMembershipEvent(logger, client, userManager, webhook.Pipe(), v)
 	case *github.MilestoneEvent:
 		repoLogin := getRepoOwnerLogin(v.Repo)
 		userManager := NewUserManager(webhook.CustomerID(), []string{repoLogin}, webhook, webhook.State(), webhook.Pipe(), g, webhook.IntegrationInstanceID(), false)
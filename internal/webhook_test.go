@@ -133,7 +133,7 @@ func TestInstallRepoWebhookIfRequiredMigrateToNew(t *testing.T) {
 			path := getEndpoint(options)
 			assert.EqualValues("/repos/pinpt/pipeline/hooks", path)
 			buf, _ := ioutil.ReadAll(data)
-			assert.EqualValues(fmt.Sprintf("{\"active\":true,\"config\":{\"content_type\":\"json\",\"insecure_ssl\":\"0\",\"secret\":\"pinpoint\",\"url\":\"https://testURL.com?version=%s\"},\"events\":[\"push\",\"pull_request\",\"commit_comment\",\"issue_comment\",\"issues\",\"project_card\",\"project_column\",\"project\",\"pull_request_review\",\"pull_request_review_comment\",\"repository\",\"milestone\"],\"name\":\"web\"}", hookVersion), string(buf))
+			assert.EqualValues(fmt.Sprintf("{\"active\":true,\"config\":{\"content_type\":\"json\",\"insecure_ssl\":\"0\",\"secret\":\"pinpoint\",\"url\":\"https://testURL.com?version=%s\"},\"events\":[\"push\",\"pull_request\",\"commit_comment\",\"issue_comment\",\"issues\",\"project_card\",\"project_column\",\"project\",\"pull_request_review\",\"pull_request_review_comment\",\"pull_request_review_thread\",\"repository\",\"milestone\",\"create\",\"delete\",\"release\",\"deployment\",\"deployment_status\",\"workflow_run\",\"workflow_job\",\"check_suite\",\"check_run\",\"status\",\"dependabot_alert\",\"code_scanning_alert\",\"secret_scanning_alert\",\"repository_vulnerability_alert\"],\"name\":\"web\"}", hookVersion), string(buf))
 			createdWebhook = true
 			return returnJSONFromFile("testdata/create_repo_webhook_response.json", http.StatusCreated, out)
 		}